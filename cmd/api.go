@@ -2,17 +2,28 @@ package cmd
 
 import (
 	"context"
+	"crypto/subtle"
+	"net"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
 	"github.com/MaximChernomorov/challenge-test/internal/api"
+	"github.com/MaximChernomorov/challenge-test/internal/grpcapi"
+	"github.com/MaximChernomorov/challenge-test/internal/importjob"
+	"github.com/MaximChernomorov/challenge-test/internal/metrics"
 	"github.com/MaximChernomorov/challenge-test/internal/repository"
+	"github.com/friendsofgo/errors"
+	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/labstack/gommon/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"google.golang.org/grpc"
 )
 
 var apiCmd = &cobra.Command{
@@ -22,54 +33,237 @@ var apiCmd = &cobra.Command{
 	},
 }
 
+const (
+	defaultBatchLocateLimit = 1000
+	defaultNearestLimit     = 100
+	defaultSearchLimit      = 500
+	defaultGRPCAddr         = ":3012"
+
+	defaultImportQueueSize     = 10
+	defaultImportJobsToKeep    = 100
+	defaultImportMaxUploadSize = "1G"
+
+	defaultCacheSize        = 100000
+	defaultCacheTTL         = 10 * time.Minute
+	defaultCacheNegativeTTL = time.Minute
+)
+
 func init() {
+	viper.SetDefault("batchLocateLimit", defaultBatchLocateLimit)
+	viper.SetDefault("nearestLimit", defaultNearestLimit)
+	viper.SetDefault("searchLimit", defaultSearchLimit)
+	viper.SetDefault("grpcAddr", defaultGRPCAddr)
+	viper.SetDefault("memory.importFormat", formatCSV)
+	viper.SetDefault("imports.queueSize", defaultImportQueueSize)
+	viper.SetDefault("imports.keepJobs", defaultImportJobsToKeep)
+	viper.SetDefault("imports.maxUploadSize", defaultImportMaxUploadSize)
+	viper.SetDefault("cache.enabled", true)
+	viper.SetDefault("cache.size", defaultCacheSize)
+	viper.SetDefault("cache.ttl", defaultCacheTTL)
+	viper.SetDefault("cache.negativeTTL", defaultCacheNegativeTTL)
 	rootCmd.AddCommand(apiCmd)
 }
 
 func startAPI() {
 	e := echo.New()
 	e.Logger.SetLevel(log.ERROR)
+	ipExtractor, err := api.NewIPExtractor(viper.GetStringSlice("trustedProxies"))
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+	e.IPExtractor = ipExtractor
+	registry := newMetricsRegistry()
 	e.Use(middleware.Logger())
+	// metrics go before recover middleware, so panics are counted as internal errors
+	e.Use(metrics.NewHTTPMetrics(registry).Middleware())
 	e.Use(middleware.RecoverWithConfig(middleware.RecoverConfig{
 		StackSize: 1 << 10, // 1 KB
 		LogLevel:  log.ERROR,
 	}))
 
-	repo, err := repository.NewPostgresRepo(viper.GetString("psqURL"))
+	repo, err := newRepo()
 	if err != nil {
 		e.Logger.Fatal(err)
 	}
+	if viper.GetString("repository") == repositoryMemory && len(viper.GetString("memory.importFile")) != 0 {
+		err = importMemoryRepo(repo, viper.GetString("memory.importFile"))
+		if err != nil {
+			e.Logger.Fatal(err)
+		}
+	}
 	defer func() {
 		err = repo.Close()
 		if err != nil {
 			e.Logger.Fatal(err)
 		}
 	}()
+	if postgresRepo, ok := repo.(*repository.PostgresRepo); ok {
+		registry.MustRegister(collectors.NewDBStatsCollector(postgresRepo.DB(), "geolocations"))
+	}
 	APIInstance := &api.API{}
+	listenCtx, stopListen := context.WithCancel(context.Background())
+	defer stopListen()
+	if viper.GetBool("cache.enabled") {
+		cachedRepo := newCachedRepo(listenCtx, e, repo)
+		metrics.RegisterCache(registry, cachedRepo)
+		APIInstance.SetCache(cachedRepo)
+		repo = cachedRepo
+	}
+	repo = metrics.NewInstrumentedRepo(repo, registry)
 	APIInstance.SetRepo(repo)
+	APIInstance.SetBatchLimit(viper.GetInt("batchLocateLimit"))
+	APIInstance.SetNearestLimit(viper.GetInt("nearestLimit"))
+	APIInstance.SetSearchLimit(viper.GetInt("searchLimit"))
+	importJobs := importjob.NewManager(
+		importJobRunner{repo: repo},
+		viper.GetInt("imports.queueSize"),
+		viper.GetInt("imports.keepJobs"),
+	)
+	defer importJobs.Close()
+	APIInstance.SetImportJobs(importJobs, api.ImportJobsOptions{
+		SourceDir: viper.GetString("imports.sourceDir"),
+		UploadDir: viper.GetString("imports.uploadDir"),
+	})
 
 	apiGroup := e.Group("/api")
 	apiGroup.POST("/ip/locate", APIInstance.LocateIP)
+	apiGroup.POST("/ip/locate/batch", APIInstance.LocateIPBatch)
+	apiGroup.GET("/ip/me", APIInstance.LocateCallerIP)
+	apiGroup.GET("/ip/:ip", APIInstance.LocateIPByPath)
+	apiGroup.GET("/geo/nearest", APIInstance.Nearest)
+	apiGroup.GET("/geolocations", APIInstance.SearchGeolocations)
+	apiGroup.GET("/stats", APIInstance.Stats)
+	apiGroup.GET("/stats/countries", APIInstance.CountryStats)
+	apiGroup.GET("/stats/cities", APIInstance.CityStats)
 
-	docsGroup := e.Group("/docs", middleware.BasicAuth(func(username, password string, c echo.Context) (bool, error) {
-		if username == viper.Sub("docsAuth").GetString("user") && password == viper.Sub("docsAuth").GetString("pass") {
-			return true, nil
-		}
-		return false, nil
-	}))
+	adminAuth, err := basicAuth("adminAuth")
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+	adminGroup := apiGroup.Group("/admin", adminAuth)
+	adminGroup.GET("/cache", APIInstance.CacheStats)
+	adminGroup.POST("/imports", APIInstance.SubmitImport, middleware.BodyLimit(viper.GetString("imports.maxUploadSize")))
+	adminGroup.GET("/imports/history", APIInstance.ImportHistory)
+	adminGroup.GET("/imports/:id", APIInstance.GetImport)
+
+	// metrics expose import and cache internals, so they are protected like admin endpoints
+	e.GET("/metrics", echo.WrapHandler(promhttp.HandlerFor(registry, promhttp.HandlerOpts{})), adminAuth)
+
+	docsAuth, err := basicAuth("docsAuth")
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+	docsGroup := e.Group("/docs", docsAuth)
 	docsGroup.Static("/", "api/docs")
 
 	go func() {
 		e.Logger.Fatal(e.Start(viper.GetString("httpAddr")))
 	}()
 
+	grpcServer := grpc.NewServer()
+	healthServer := grpcapi.NewServer(repo, viper.GetInt("batchLocateLimit")).Register(grpcServer)
+	if len(viper.GetString("grpcAddr")) != 0 {
+		listener, err := net.Listen("tcp", viper.GetString("grpcAddr"))
+		if err != nil {
+			e.Logger.Fatal(err)
+		}
+		go func() {
+			e.Logger.Fatal(grpcServer.Serve(listener))
+		}()
+	}
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGTERM)
 	signal.Notify(quit, syscall.SIGINT)
 	<-quit
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
+	healthServer.Shutdown()
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
 	if err := e.Shutdown(ctx); err != nil {
 		e.Logger.Fatal(err)
 	}
+	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+		grpcServer.Stop()
+	}
+}
+
+// importMemoryRepo fills in-memory repository from file of memory.importFormat, as it starts empty on every run
+func importMemoryRepo(repo repository.Repository, path string) error {
+	sourceImporter, err := newImporter(viper.GetString("memory.importFormat"))
+	if err != nil {
+		return err
+	}
+	sources, err := expandSourcePaths([]string{path})
+	if err != nil {
+		return err
+	}
+	validator, err := newValidator()
+	if err != nil {
+		return err
+	}
+	_, err = importSources(context.Background(), repo, importInput{
+		importer:  sourceImporter,
+		sources:   sources,
+		validator: validator,
+	}, repository.ImportOptions{
+		Mode:    repository.ImportModeReplace,
+		Promote: true,
+	})
+
+	return errors.Wrap(err, "failed to import memory repository file")
+}
+
+// newMetricsRegistry creates registry of api metrics with go runtime and process ones
+func newMetricsRegistry() *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	return registry
+}
+
+// basicAuth checks credentials against user and pass of configKey, both of them are required, otherwise anyone
+// passing empty credentials would be let in
+func basicAuth(configKey string) (echo.MiddlewareFunc, error) {
+	user := []byte(viper.GetString(configKey + ".user"))
+	pass := []byte(viper.GetString(configKey + ".pass"))
+	if len(user) == 0 || len(pass) == 0 {
+		return nil, errors.Errorf("%[1]s.user and %[1]s.pass must be configured", configKey)
+	}
+
+	return middleware.BasicAuth(func(username, password string, c echo.Context) (bool, error) {
+		// both are compared whatever the first result is, so timing doesn't tell which one is wrong
+		userMatches := subtle.ConstantTimeCompare([]byte(username), user) == 1
+		passMatches := subtle.ConstantTimeCompare([]byte(password), pass) == 1
+		return userMatches && passMatches, nil
+	}), nil
+}
+
+// newCachedRepo wraps repo with lookup cache. Datasets of postgres repository are promoted by import command running
+// in another process, so cache is invalidated by db notifications
+func newCachedRepo(ctx context.Context, e *echo.Echo, repo repository.Repository) *repository.CachedRepo {
+	cachedRepo := repository.NewCachedRepo(repo, repository.CacheOptions{
+		Size:        viper.GetInt("cache.size"),
+		TTL:         viper.GetDuration("cache.ttl"),
+		NegativeTTL: viper.GetDuration("cache.negativeTTL"),
+	})
+	if viper.GetString("repository") == repositoryPostgres {
+		go func() {
+			err := repository.ListenDatasetPromotions(ctx, viper.GetString("psqURL"), cachedRepo.Invalidate)
+			if err != nil {
+				e.Logger.Error(err)
+			}
+		}()
+	}
+
+	return cachedRepo
 }
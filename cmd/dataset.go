@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+var datasetCmd = &cobra.Command{
+	Use:   "dataset",
+	Short: "manage imported dataset versions",
+}
+
+var datasetListCmd = &cobra.Command{
+	Use:  "list",
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		withRepo(listDatasets)
+	},
+}
+
+var datasetPromoteCmd = &cobra.Command{
+	Use:  "promote <id>",
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		id, err := strconv.Atoi(args[0])
+		cobra.CheckErr(err)
+		withRepo(func(ctx context.Context, repo repository.Repository) {
+			cobra.CheckErr(repo.PromoteDataset(ctx, id))
+			fmt.Println("dataset promoted", id)
+		})
+	},
+}
+
+var datasetRollbackCmd = &cobra.Command{
+	Use:  "rollback",
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		withRepo(func(ctx context.Context, repo repository.Repository) {
+			id, err := repo.RollbackDataset(ctx)
+			cobra.CheckErr(err)
+			fmt.Println("dataset promoted", id)
+		})
+	},
+}
+
+func init() {
+	datasetCmd.AddCommand(datasetListCmd, datasetPromoteCmd, datasetRollbackCmd)
+	rootCmd.AddCommand(datasetCmd)
+}
+
+func withRepo(fn func(ctx context.Context, repo repository.Repository)) {
+	repo, err := newRepo()
+	cobra.CheckErr(err)
+	defer func() {
+		cobra.CheckErr(repo.Close())
+	}()
+	fn(context.Background(), repo)
+}
+
+func listDatasets(ctx context.Context, repo repository.Repository) {
+	datasets, err := repo.ListDatasets(ctx)
+	cobra.CheckErr(err)
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tACTIVE\tROWS\tCREATED\tPROMOTED")
+	for _, dataset := range datasets {
+		promotedAt := "-"
+		if dataset.PromotedAt.Valid {
+			promotedAt = dataset.PromotedAt.Time.Format(time.RFC3339)
+		}
+		fmt.Fprintf(
+			writer,
+			"%d\t%t\t%d\t%s\t%s\n",
+			dataset.ID,
+			dataset.IsActive,
+			dataset.RowsCount,
+			dataset.CreatedAt.Format(time.RFC3339),
+			promotedAt,
+		)
+	}
+	cobra.CheckErr(writer.Flush())
+}
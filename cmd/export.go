@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository"
+	"github.com/MaximChernomorov/challenge-test/pkg/exporter"
+	importerPkg "github.com/MaximChernomorov/challenge-test/pkg/importer"
+	"github.com/friendsofgo/errors"
+	"github.com/spf13/cobra"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "export active dataset, csv export is imported back unchanged",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		withRepo(export)
+	},
+}
+
+const (
+	formatParquet = "parquet"
+	// stdoutPath writes export to stdout
+	stdoutPath = "-"
+)
+
+var (
+	exportFormat      string
+	exportPath        string
+	exportCountryCode string
+	exportImportID    int
+	mmdbDBType        string
+	mmdbDescription   string
+)
+
+func init() {
+	exportCmd.Flags().StringVar(
+		&exportFormat,
+		"format",
+		formatCSV,
+		"--format=csv|jsonl|parquet|mmdb output file format, csv and jsonl are imported back as is",
+	)
+	exportCmd.Flags().StringVarP(
+		&exportPath,
+		"output",
+		"o",
+		"",
+		"--output=geolocations.csv, geolocations.<format> by default, - writes stdout",
+	)
+	exportCmd.Flags().StringVar(
+		&exportCountryCode,
+		"country",
+		"",
+		"--country=NP exports rows of country only, code of reference country is matched if row refers to one",
+	)
+	exportCmd.Flags().IntVar(
+		&exportImportID,
+		"import",
+		0,
+		"--import=12 exports rows written by import run only, see import history",
+	)
+	exportCmd.Flags().IntVar(
+		&batchSize,
+		"batch-size",
+		defaultBatchSize,
+		"--batch-size=10000 rows read from db at once",
+	)
+	exportCmd.Flags().StringVar(
+		&mmdbDBType,
+		"mmdb-database-type",
+		exporter.DefaultMMDBDatabaseType,
+		"--mmdb-database-type=GeoLite2-City database type written into mmdb metadata",
+	)
+	exportCmd.Flags().StringVar(
+		&mmdbDescription,
+		"mmdb-description",
+		"IP geolocations",
+		"--mmdb-description=\"IP geolocations\" description written into mmdb metadata",
+	)
+	rootCmd.AddCommand(exportCmd)
+}
+
+func export(ctx context.Context, repo repository.Repository) {
+	if len(exportPath) == 0 {
+		exportPath = "geolocations." + exportFormat
+	}
+	start := time.Now()
+	// report is written to stderr if export is written to stdout
+	report := os.Stdout
+	destination := os.Stdout
+	if exportPath == stdoutPath {
+		report = os.Stderr
+	} else {
+		var err error
+		destination, err = os.Create(exportPath)
+		cobra.CheckErr(err)
+		defer destination.Close()
+	}
+
+	exported, err := exportDataset(ctx, repo, destination)
+	if err != nil {
+		// partially written file is useless
+		if exportPath != stdoutPath {
+			_ = os.Remove(exportPath)
+		}
+		cobra.CheckErr(err)
+	}
+
+	fmt.Fprintln(report, "rows exported", exported)
+	fmt.Fprintln(report, "written to", exportPath)
+	fmt.Fprintln(report, "time elapsed", time.Since(start))
+}
+
+// exportDataset writes rows of active dataset matching filter flags into destination in exportFormat and returns
+// count of exported rows
+func exportDataset(ctx context.Context, repo repository.Repository, destination io.Writer) (int, error) {
+	rowsExporter, err := newExporter(exportFormat, destination)
+	if err != nil {
+		return 0, err
+	}
+	exported := 0
+	options := repository.ExportOptions{
+		BatchSize:   batchSize,
+		CountryCode: exportCountryCode,
+		ImportID:    exportImportID,
+	}
+	err = repo.ExportGeolocations(ctx, options, func(batch repository.GeolocationSlice) error {
+		exported += batch.GetLength()
+		return rowsExporter.Write(getCSVRowsByGeoSlice(batch))
+	})
+	if err != nil {
+		return exported, err
+	}
+
+	return exported, rowsExporter.Close()
+}
+
+// newExporter returns exporter of destination format
+func newExporter(format string, destination io.Writer) (exporter.Exporter, error) {
+	switch format {
+	case formatCSV:
+		return exporter.NewCSVExporter(destination)
+	case formatJSONL:
+		return exporter.NewJSONLExporter(destination)
+	case formatParquet:
+		return exporter.NewParquetExporter(destination)
+	case formatMMDB:
+		return exporter.NewMMDBExporter(destination, exporter.MMDBOptions{
+			DatabaseType: mmdbDBType,
+			Description:  mmdbDescription,
+		})
+	default:
+		return nil, errors.Errorf("unknown format %q", format)
+	}
+}
+
+// getCSVRowsByGeoSlice converts geolocations back into rows they are imported from, single IP networks are written
+// as plain IP addresses. Reference country isn't exported, it is resolved again on import
+func getCSVRowsByGeoSlice(geoSlice repository.GeolocationSlice) []importerPkg.CSVRow {
+	rows := make([]importerPkg.CSVRow, 0, geoSlice.GetLength())
+	for _, location := range geoSlice.GeolocationSlice {
+		rows = append(rows, importerPkg.CSVRow{
+			IPAddress:    location.IPAddress,
+			CountryCode:  location.CountryCode.String,
+			Country:      location.Country.String,
+			City:         location.City.String,
+			Latitude:     location.Coordinates.X,
+			Longitude:    location.Coordinates.Y,
+			MysteryValue: location.MysteryValue.String,
+		})
+	}
+
+	return rows
+}
@@ -2,47 +2,186 @@ package cmd
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"hash"
+	"io"
 	"os"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/MaximChernomorov/challenge-test/internal/metrics"
 	"github.com/MaximChernomorov/challenge-test/internal/repository"
 	"github.com/MaximChernomorov/challenge-test/internal/repository/model"
 	importerPkg "github.com/MaximChernomorov/challenge-test/pkg/importer"
+	"github.com/friendsofgo/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/types/pgeo"
 )
 
-const defaultFilePath = "data_dump.csv"
+const (
+	defaultFilePath  = "data_dump.csv"
+	defaultBatchSize = 10000
+
+	formatCSV   = "csv"
+	formatJSONL = "jsonl"
+	formatMMDB  = "mmdb"
+
+	defaultDatasetsToKeep = 3
+
+	// batchesBuffer is count of batches waiting to be written into db, together with batch size it limits memory
+	// used by import
+	batchesBuffer = 4
+)
 
 var importCmd = &cobra.Command{
-	Use:  "import",
-	Args: cobra.RangeArgs(0, 1),
+	Use:  "import [file paths or globs]",
+	Args: cobra.ArbitraryArgs,
 	Run: func(cmd *cobra.Command, args []string) {
-		importer()
+		paths := filePaths
+		if len(args) != 0 && !cmd.Flags().Changed("file-path") {
+			paths = args
+		} else {
+			paths = append(paths, args...)
+		}
+		importer(paths)
 	},
 }
 
-var filePath string
+var (
+	filePaths     []string
+	batchSize     int
+	importFormat  string
+	importMode    string
+	deleteMissing bool
+	noPromote     bool
+	rejectedOut   string
+	duplicates    string
+	metricsPush   string
+	metricsFile   string
+	dryRun        bool
+	reportSamples int
+)
 
 func init() {
-	importCmd.Flags().StringVarP(
-		&filePath,
+	importCmd.Flags().StringSliceVarP(
+		&filePaths,
 		"file-path",
 		"p",
-		defaultFilePath,
-		"--file-path=data_dump.csv",
+		[]string{defaultFilePath},
+		"--file-path=data_dump.csv, could be repeated or contain globs like shards/*.csv.gz, - reads stdin. "+
+			"gzip and zstd sources are decompressed",
+	)
+	importCmd.Flags().IntVar(
+		&batchSize,
+		"batch-size",
+		defaultBatchSize,
+		"--batch-size=10000 rows passed to db at once",
+	)
+	importCmd.Flags().StringVar(
+		&importFormat,
+		"format",
+		formatCSV,
+		"--format=csv|jsonl|mmdb source file format",
+	)
+	importCmd.Flags().StringVar(
+		&importMode,
+		"mode",
+		string(repository.ImportModeAppend),
+		"--mode=append|merge|replace, new dataset is built from the active one and source: append fails on existing "+
+			"IP addresses, merge updates them, replace ignores the active dataset",
+	)
+	importCmd.Flags().BoolVar(
+		&deleteMissing,
+		"delete-missing",
+		false,
+		"--delete-missing deletes IP addresses absent in source, merge mode only",
+	)
+	importCmd.Flags().BoolVar(
+		&noPromote,
+		"no-promote",
+		false,
+		"--no-promote keeps the current dataset active, use `dataset promote` later",
+	)
+	importCmd.Flags().StringVar(
+		&rejectedOut,
+		"rejected-out",
+		"",
+		"--rejected-out=rejected.csv writes discarded rows with line number and reason",
+	)
+	importCmd.Flags().StringVar(
+		&duplicates,
+		"duplicates",
+		string(importerPkg.DuplicatePolicyFirstWins),
+		"--duplicates=first-wins|last-wins|reject-all-conflicting|keep-identical-only resolves rows of the same "+
+			"network: first-wins and last-wins keep one of them, reject-all-conflicting drops network if its rows "+
+			"differ, keep-identical-only fails import if they differ. Every policy keeps seen networks in memory, "+
+			"last-wins and reject-all-conflicting hold all rows until sources are read",
 	)
+	importCmd.Flags().StringVar(
+		&metricsPush,
+		"metrics-push",
+		"",
+		"--metrics-push=http://localhost:9091 pushes import metrics to prometheus pushgateway",
+	)
+	importCmd.Flags().StringVar(
+		&metricsFile,
+		"metrics-textfile",
+		"",
+		"--metrics-textfile=import.prom writes import metrics for node exporter textfile collector",
+	)
+	importCmd.Flags().BoolVar(
+		&dryRun,
+		"dry-run",
+		false,
+		"--dry-run reads and checks sources without opening db and prints json report of their rows",
+	)
+	importCmd.Flags().IntVar(
+		&reportSamples,
+		"samples",
+		defaultReportSamples,
+		"--samples=5 discarded rows of every reason included in dry run report",
+	)
+	viper.SetDefault("datasets.keep", defaultDatasetsToKeep)
+	viper.SetDefault("validation.rules", []string{})
 	rootCmd.AddCommand(importCmd)
 }
 
-func importer() {
-	var err error
-	ctx := context.Background()
+func importer(paths []string) {
+	options, err := newImportOptions(importMode, deleteMissing, !noPromote)
+	cobra.CheckErr(err)
+	sourceImporter, err := newImporter(importFormat)
+	cobra.CheckErr(err)
+	duplicatePolicy, err := importerPkg.ParseDuplicatePolicy(duplicates)
+	cobra.CheckErr(err)
+	validator, err := newValidator()
+	cobra.CheckErr(err)
+	sources, err := expandSourcePaths(paths)
+	cobra.CheckErr(err)
+	var rejectedWriter *importerPkg.RejectedWriter
+	if len(rejectedOut) != 0 {
+		rejectedFile, err := os.Create(rejectedOut)
+		cobra.CheckErr(err)
+		defer rejectedFile.Close()
+		rejectedWriter = importerPkg.NewRejectedWriter(rejectedFile)
+	}
+	input := importInput{
+		importer:        sourceImporter,
+		sources:         sources,
+		duplicatePolicy: duplicatePolicy,
+		validator:       validator,
+		rejectedWriter:  rejectedWriter,
+	}
+	if dryRun {
+		printDryRunReport(context.Background(), input, reportSamples)
+		return
+	}
 
-	repo, err := repository.NewPostgresRepo(viper.GetString("psqURL"))
+	repo, err := newRepo()
 	cobra.CheckErr(err)
 	defer func() {
 		err = repo.Close()
@@ -51,35 +190,305 @@ func importer() {
 		}
 	}()
 	start := time.Now()
-	sourceFile, err := os.Open(filePath)
-	cobra.CheckErr(err)
 
-	rows := &importerPkg.CSVRows{}
-	csvImporter := importerPkg.GetCSVImporter()
-	err = csvImporter.Import(sourceFile, rows)
+	summary, err := importSources(context.Background(), repo, input, options)
+	// rejected rows and metrics are reported for failed import too, so it could be investigated and alerts can fire
+	if rejectedWriter != nil {
+		cobra.CheckErr(rejectedWriter.Flush())
+	}
+	cobra.CheckErr(reportImportMetrics(summary, time.Since(start), err != nil))
 	cobra.CheckErr(err)
 
-	geoSlice := getGeoSliceByCSVRows(rows)
+	fmt.Println("import recorded", summary.importID)
+	fmt.Println("sources imported", len(sources))
+	fmt.Println("rows accepted", summary.rows.GetAcceptedCnt())
+	fmt.Println("networks stored", summary.networks)
+	fmt.Println("rows discarded", summary.rows.GetDiscardedCnt())
+	printDiscardedByReason(summary.rows.GetDiscardedCntByReason())
+	fmt.Println("networks inserted", summary.result.Inserted)
+	if options.Mode == repository.ImportModeMerge {
+		fmt.Println("networks updated", summary.result.Updated)
+		fmt.Println("networks unchanged", summary.result.Unchanged)
+		fmt.Println("networks deleted", summary.result.Deleted)
+	}
+	if noPromote {
+		fmt.Println("dataset loaded", summary.result.DatasetID)
+	} else {
+		fmt.Println("dataset promoted", summary.result.DatasetID)
+	}
+	fmt.Println("datasets pruned", summary.result.Pruned)
+	fmt.Println("time elapsed", time.Since(start))
+}
 
-	err = repo.AddGeolocationSlice(ctx, geoSlice)
-	cobra.CheckErr(err)
+// importInput describes sources of import run and how their rows are read
+type importInput struct {
+	importer        importerPkg.Importer
+	sources         []string
+	duplicatePolicy importerPkg.DuplicatePolicy
+	validator       *importerPkg.Validator
+	// rejectedWriter receives discarded rows if it is set
+	rejectedWriter *importerPkg.RejectedWriter
+	// progress is called after every batch of networks if it is set
+	progress func(rows *importerPkg.CSVRowsStream, networks int)
+	// name is source name recorded in import history, paths of sources are used if it is empty
+	name string
+}
 
-	fmt.Println("rows accepted", geoSlice.GetLength())
-	fmt.Println("rows discarded", rows.GetDiscardedCnt())
-	fmt.Println("time elapsed", time.Since(start))
+// importSummary describes finished import run
+type importSummary struct {
+	// importID is id of import run in import history
+	importID int
+	rows     *importerPkg.CSVRowsStream
+	networks int
+	result   repository.ImportResult
+}
+
+// newImportOptions checks import mode and returns repository options, datasets beyond `datasets.keep` config key are
+// pruned after import
+func newImportOptions(mode string, deleteMissing, promote bool) (repository.ImportOptions, error) {
+	options := repository.ImportOptions{
+		Mode:          repository.ImportMode(mode),
+		DeleteMissing: deleteMissing,
+		Promote:       promote,
+		KeepDatasets:  viper.GetInt("datasets.keep"),
+	}
+	switch options.Mode {
+	case repository.ImportModeAppend, repository.ImportModeMerge, repository.ImportModeReplace:
+	default:
+		return options, errors.Errorf("unknown import mode %q", mode)
+	}
+	if deleteMissing && options.Mode != repository.ImportModeMerge {
+		return options, errors.New("deleting missing networks is supported only in merge mode")
+	}
+
+	return options, nil
+}
+
+// newImporter returns importer of source format
+func newImporter(format string) (importerPkg.Importer, error) {
+	switch format {
+	case formatCSV:
+		return importerPkg.GetCSVImporter(), nil
+	case formatJSONL:
+		return importerPkg.GetJSONLImporter(), nil
+	case formatMMDB:
+		return importerPkg.GetMMDBImporter(), nil
+	default:
+		return nil, errors.Errorf("unknown format %q", format)
+	}
+}
+
+// newValidator returns validator of rules enabled by `validation.rules` config key
+func newValidator() (*importerPkg.Validator, error) {
+	return importerPkg.NewValidatorByNames(viper.GetStringSlice("validation.rules"))
+}
+
+// importSources streams rows imported from input sources one by one into repo as single new dataset. Import run is
+// recorded in import history whatever its result is
+func importSources(
+	ctx context.Context,
+	repo repository.Repository,
+	input importInput,
+	options repository.ImportOptions,
+) (importSummary, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	summary := importSummary{rows: importerPkg.NewCSVRowsStream(ctx, batchSize, batchesBuffer)}
+	summary.rows.SetDuplicatePolicy(input.duplicatePolicy)
+	summary.rows.SetValidator(input.validator)
+	if input.rejectedWriter != nil {
+		summary.rows.SetRejectedWriter(input.rejectedWriter)
+	}
+	name := input.name
+	if len(name) == 0 {
+		name = strings.Join(input.sources, ",")
+	}
+	run, err := repo.StartImport(ctx, name)
+	if err != nil {
+		return summary, err
+	}
+	summary.importID = run.ID
+
+	checksum := sha256.New()
+	importDone := make(chan error, 1)
+	go func() {
+		defer summary.rows.Close()
+		err := importEachSource(input.importer, input.sources, summary.rows, checksum)
+		if err != nil {
+			// nothing should be stored from partially read source
+			cancel()
+		}
+		importDone <- err
+	}()
+
+	geoBatches := make(chan repository.GeolocationSlice)
+	convertDone := make(chan struct{})
+	go func() {
+		defer close(convertDone)
+		defer close(geoBatches)
+		for batch := range summary.rows.Batches() {
+			geoSlice := getGeoSliceByCSVRows(batch, run.ID)
+			summary.networks += geoSlice.GetLength()
+			if input.progress != nil {
+				input.progress(summary.rows, summary.networks)
+			}
+			select {
+			case geoBatches <- geoSlice:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var repoErr error
+	summary.result, repoErr = repo.ImportGeolocationBatches(ctx, geoBatches, options)
+	cancel()
+	importErr := <-importDone
+	<-convertDone
+	err = importRootCause(importErr, repoErr)
+
+	// cancelled import is recorded too
+	finishErr := repo.FinishImport(context.Background(), finishedImportRun(run, summary, checksum, err))
+	if err != nil {
+		return summary, err
+	}
+
+	return summary, finishErr
+}
+
+// importRootCause returns the first error of import stages, failed stage cancels the other one, so root cause rather
+// than cancellation is preferred
+func importRootCause(errs ...error) error {
+	for _, err := range errs {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			return err
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// finishedImportRun fills import run with its result. Checksum and dataset are recorded only if import succeeded, as
+// failed import could stop reading sources before their end and its dataset is rolled back
+func finishedImportRun(
+	run repository.ImportRun,
+	summary importSummary,
+	checksum hash.Hash,
+	err error,
+) repository.ImportRun {
+	run.Accepted = summary.rows.GetAcceptedCnt()
+	run.Discarded = summary.rows.GetDiscardedCnt()
+	run.DiscardedByReason = make(repository.DiscardedByReason)
+	for reason, count := range summary.rows.GetDiscardedCntByReason() {
+		run.DiscardedByReason[string(reason)] = count
+	}
+	if err != nil {
+		run.Status = repository.ImportStatusFailed
+		run.Error = null.StringFrom(err.Error())
+		return run
+	}
+	run.Status = repository.ImportStatusSucceeded
+	run.Checksum = null.StringFrom(hex.EncodeToString(checksum.Sum(nil)))
+	run.DatasetID = null.IntFrom(summary.result.DatasetID)
+
+	return run
 }
 
-func getGeoSliceByCSVRows(rows *importerPkg.CSVRows) repository.GeolocationSlice {
-	geoSlice := make(model.GeolocationSlice, 0)
-	for _, row := range rows.GetRows() {
-		geoSlice = append(geoSlice, &model.Geolocation{
-			IPAddress:    row.IPAddress,
-			CountryCode:  null.StringFrom(row.CountryCode),
-			Country:      null.StringFrom(row.Country),
-			City:         null.StringFrom(row.City),
-			Coordinates:  pgeo.NewPoint(row.Latitude, row.Longitude),
-			MysteryValue: null.StringFrom(row.MysteryValue),
-		})
+// reportImportMetrics pushes and writes metrics of import run if it is configured by flags
+func reportImportMetrics(summary importSummary, duration time.Duration, failed bool) error {
+	if len(metricsPush) == 0 && len(metricsFile) == 0 {
+		return nil
+	}
+	run := metrics.ImportRun{
+		Accepted:          summary.rows.GetAcceptedCnt(),
+		DiscardedByReason: make(map[string]int),
+		Networks:          summary.networks,
+		Duration:          duration,
+		Failed:            failed,
+	}
+	for reason, count := range summary.rows.GetDiscardedCntByReason() {
+		run.DiscardedByReason[string(reason)] = count
+	}
+	if len(metricsPush) != 0 {
+		err := run.Push(metricsPush)
+		if err != nil {
+			return err
+		}
+	}
+	if len(metricsFile) != 0 {
+		return run.WriteTextfile(metricsFile)
+	}
+
+	return nil
+}
+
+// importEachSource imports sources into rows, source is opened only when previous one is imported. Raw bytes of all
+// sources are written to checksum one after another. Rows held by duplicate policy are passed once all sources are
+// imported
+func importEachSource(
+	sourceImporter importerPkg.Importer,
+	sources []string,
+	rows *importerPkg.CSVRowsStream,
+	checksum io.Writer,
+) error {
+	for _, path := range sources {
+		source, err := openSource(path, checksum)
+		if err != nil {
+			return err
+		}
+		rows.SetSource(path)
+		err = sourceImporter.Import(source, rows)
+		if err == nil {
+			err = source.drain()
+		}
+		_ = source.Close()
+		if err != nil {
+			return errors.Wrapf(err, "failed to import %s", path)
+		}
+	}
+
+	return rows.Finish()
+}
+
+func printDiscardedByReason(discardedByReason map[importerPkg.DiscardReason]int) {
+	reasons := make([]string, 0, len(discardedByReason))
+	for reason := range discardedByReason {
+		reasons = append(reasons, string(reason))
+	}
+	sort.Strings(reasons)
+	for _, reason := range reasons {
+		fmt.Printf("  %s %d\n", reason, discardedByReason[importerPkg.DiscardReason(reason)])
+	}
+}
+
+// getGeoSliceByCSVRows converts rows into geolocations written by import run
+func getGeoSliceByCSVRows(rows []importerPkg.CSVRow, importID int) repository.GeolocationSlice {
+	geoSlice := make(model.GeolocationSlice, 0, len(rows))
+	for _, row := range rows {
+		// rows are validated by importer, so networks are always parsable here
+		networks, _ := row.Networks()
+		countryAlpha2 := null.String{}
+		if country, found := row.ResolveCountry(); found {
+			countryAlpha2 = null.StringFrom(country.Code)
+		}
+		for _, network := range networks {
+			geoSlice = append(geoSlice, &model.Geolocation{
+				IPAddress:     importerPkg.FormatNetwork(network),
+				CountryCode:   null.StringFrom(row.CountryCode),
+				Country:       null.StringFrom(row.Country),
+				City:          null.StringFrom(row.City),
+				Coordinates:   pgeo.NewPoint(row.Latitude, row.Longitude),
+				MysteryValue:  null.StringFrom(row.MysteryValue),
+				CountryAlpha2: countryAlpha2,
+				ImportID:      null.IntFrom(importID),
+			})
+		}
 	}
 	return repository.GeolocationSlice{GeolocationSlice: geoSlice}
 }
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	importerPkg "github.com/MaximChernomorov/challenge-test/pkg/importer"
+	"github.com/spf13/cobra"
+)
+
+// defaultReportSamples is count of discarded rows of every reason included in dry run report
+const defaultReportSamples = 5
+
+// dryRunReport is printed by import --dry-run
+type dryRunReport struct {
+	Sources []string `json:"sources"`
+	// Error is set if sources couldn't be read to the end or duplicate policy failed, report covers rows read before
+	Error string `json:"error,omitempty"`
+	importerPkg.Report
+}
+
+// printDryRunReport reads input sources the same way as import does but never opens db, json report of rows is
+// printed to stdout and rejected rows are written even if import fails
+func printDryRunReport(ctx context.Context, input importInput, samples int) {
+	report, err := validateSources(ctx, input, samples)
+	if err != nil {
+		report.Error = err.Error()
+	}
+	if input.rejectedWriter != nil {
+		cobra.CheckErr(input.rejectedWriter.Flush())
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	cobra.CheckErr(encoder.Encode(report))
+	cobra.CheckErr(err)
+}
+
+// validateSources passes rows of input sources through sanitisation, validation and duplicate policy and reports
+// them, rows are dropped once reported
+func validateSources(ctx context.Context, input importInput, samples int) (dryRunReport, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	rows := importerPkg.NewCSVRowsStream(ctx, batchSize, batchesBuffer)
+	rows.SetDuplicatePolicy(input.duplicatePolicy)
+	rows.SetValidator(input.validator)
+	if input.rejectedWriter != nil {
+		rows.SetRejectedWriter(input.rejectedWriter)
+	}
+	reporter := importerPkg.NewReporter(samples)
+	rows.SetReporter(reporter)
+
+	importDone := make(chan error, 1)
+	go func() {
+		defer rows.Close()
+		importDone <- importEachSource(input.importer, input.sources, rows, nil)
+	}()
+	for range rows.Batches() {
+	}
+	err := <-importDone
+
+	return dryRunReport{Sources: input.sources, Report: reporter.Report()}, err
+}
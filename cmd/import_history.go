@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultImportHistoryLimit = 20
+	// shortChecksumLength is count of checksum characters printed by import history
+	shortChecksumLength = 12
+)
+
+var importHistoryCmd = &cobra.Command{
+	Use:   "history",
+	Short: "list recorded import runs, the newest first",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		limit, err := getLimitFlag(cmd)
+		cobra.CheckErr(err)
+		withRepo(func(ctx context.Context, repo repository.Repository) {
+			printImportHistory(ctx, repo, limit)
+		})
+	},
+}
+
+func init() {
+	importHistoryCmd.Flags().Int("limit", defaultImportHistoryLimit, "max count of import runs")
+	importCmd.AddCommand(importHistoryCmd)
+}
+
+func printImportHistory(ctx context.Context, repo repository.Repository, limit int) {
+	runs, err := repo.ListImports(ctx, limit)
+	cobra.CheckErr(err)
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tSTATUS\tSOURCE\tSTARTED\tDURATION\tACCEPTED\tDISCARDED\tDATASET\tCHECKSUM\tERROR")
+	for _, run := range runs {
+		duration := "-"
+		if run.FinishedAt.Valid {
+			duration = run.FinishedAt.Time.Sub(run.StartedAt).Round(time.Millisecond).String()
+		}
+		datasetID := "-"
+		if run.DatasetID.Valid {
+			datasetID = strconv.Itoa(run.DatasetID.Int)
+		}
+		checksum := run.Checksum.String
+		if len(checksum) > shortChecksumLength {
+			checksum = checksum[:shortChecksumLength]
+		}
+		fmt.Fprintf(
+			writer,
+			"%d\t%s\t%s\t%s\t%s\t%d\t%s\t%s\t%s\t%s\n",
+			run.ID,
+			run.Status,
+			run.Source,
+			run.StartedAt.Format(time.RFC3339),
+			duration,
+			run.Accepted,
+			formatDiscarded(run.Discarded, run.DiscardedByReason),
+			datasetID,
+			valueOrDash(checksum),
+			valueOrDash(run.Error.String),
+		)
+	}
+	cobra.CheckErr(writer.Flush())
+}
+
+// formatDiscarded formats count of discarded rows followed by counts by reason, e.g. 3 (invalid_ip=2 missing_city=1)
+func formatDiscarded(discarded int, discardedByReason repository.DiscardedByReason) string {
+	if len(discardedByReason) == 0 {
+		return strconv.Itoa(discarded)
+	}
+	reasons := make([]string, 0, len(discardedByReason))
+	for reason, count := range discardedByReason {
+		reasons = append(reasons, fmt.Sprintf("%s=%d", reason, count))
+	}
+	sort.Strings(reasons)
+
+	return fmt.Sprintf("%d (%s)", discarded, strings.Join(reasons, " "))
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/MaximChernomorov/challenge-test/internal/importjob"
+	"github.com/MaximChernomorov/challenge-test/internal/repository"
+	importerPkg "github.com/MaximChernomorov/challenge-test/pkg/importer"
+)
+
+// importJobRunner runs import jobs submitted to api the same way import command does
+type importJobRunner struct {
+	repo repository.Repository
+}
+
+func (runner importJobRunner) Check(options importjob.Options) error {
+	_, err := newImportOptions(options.Mode, options.DeleteMissing, options.Promote)
+	if err != nil {
+		return err
+	}
+	_, err = newImporter(options.Format)
+	if err != nil {
+		return err
+	}
+	_, err = importerPkg.ParseDuplicatePolicy(options.Duplicates)
+
+	return err
+}
+
+func (runner importJobRunner) Run(
+	ctx context.Context,
+	source importjob.Source,
+	options importjob.Options,
+	progress func(importjob.Progress),
+) (importjob.Result, error) {
+	importOptions, err := newImportOptions(options.Mode, options.DeleteMissing, options.Promote)
+	if err != nil {
+		return importjob.Result{}, err
+	}
+	sourceImporter, err := newImporter(options.Format)
+	if err != nil {
+		return importjob.Result{}, err
+	}
+	duplicatePolicy, err := importerPkg.ParseDuplicatePolicy(options.Duplicates)
+	if err != nil {
+		return importjob.Result{}, err
+	}
+	validator, err := newValidator()
+	if err != nil {
+		return importjob.Result{}, err
+	}
+
+	reportProgress := func(rows *importerPkg.CSVRowsStream, networks int) {
+		progress(importjob.Progress{
+			Accepted:  rows.GetAcceptedCnt(),
+			Discarded: rows.GetDiscardedCnt(),
+			Networks:  networks,
+		})
+	}
+	summary, err := importSources(ctx, runner.repo, importInput{
+		importer:        sourceImporter,
+		sources:         []string{source.Path},
+		name:            source.Name,
+		duplicatePolicy: duplicatePolicy,
+		validator:       validator,
+		progress:        reportProgress,
+	}, importOptions)
+	reportProgress(summary.rows, summary.networks)
+	if err != nil {
+		return importjob.Result{}, err
+	}
+
+	result := importjob.Result{
+		DiscardedByReason: make(map[string]int),
+		ImportID:          summary.importID,
+		DatasetID:         summary.result.DatasetID,
+		Inserted:          summary.result.Inserted,
+		Updated:           summary.result.Updated,
+		Unchanged:         summary.result.Unchanged,
+		Deleted:           summary.result.Deleted,
+		Pruned:            summary.result.Pruned,
+	}
+	for reason, count := range summary.rows.GetDiscardedCntByReason() {
+		result.DiscardedByReason[string(reason)] = count
+	}
+
+	return result, nil
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/MaximChernomorov/challenge-test/internal/migrate"
+	"github.com/MaximChernomorov/challenge-test/internal/repository"
+	"github.com/friendsofgo/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "manage db schema with migrations embedded into binary",
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:  "up",
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		withDB(func(db *sql.DB) {
+			cobra.CheckErr(migrate.Up(db))
+		})
+	},
+}
+
+var migrateDownCmd = &cobra.Command{
+	Use:  "down",
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		withDB(func(db *sql.DB) {
+			cobra.CheckErr(migrate.Down(db))
+		})
+	},
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:  "status",
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		withDB(func(db *sql.DB) {
+			cobra.CheckErr(migrate.Status(db))
+		})
+	},
+}
+
+var migrateVersionCmd = &cobra.Command{
+	Use:  "version",
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		withDB(func(db *sql.DB) {
+			current, latest, err := migrate.Version(db)
+			cobra.CheckErr(err)
+			fmt.Println("schema version", current)
+			fmt.Println("latest migration", latest)
+		})
+	},
+}
+
+func init() {
+	viper.SetDefault("migrations.auto", false)
+	migrateCmd.AddCommand(migrateUpCmd, migrateDownCmd, migrateStatusCmd, migrateVersionCmd)
+	rootCmd.AddCommand(migrateCmd)
+}
+
+// withDB passes connection to db configured by `psqURL`, schema isn't checked, so it could be migrated
+func withDB(fn func(db *sql.DB)) {
+	repo, err := repository.NewPostgresRepo(viper.GetString("psqURL"))
+	cobra.CheckErr(err)
+	defer func() {
+		cobra.CheckErr(repo.Close())
+	}()
+	fn(repo.(*repository.PostgresRepo).DB())
+}
+
+// ensureSchema applies pending migrations if `migrations.auto` is set, otherwise it fails if there are any
+func ensureSchema(db *sql.DB) error {
+	if viper.GetBool("migrations.auto") {
+		return migrate.Up(db)
+	}
+	err := migrate.Check(db)
+	if errors.Is(err, migrate.ErrSchemaBehind) {
+		return errors.Wrap(err, "run `migrate up` or set migrations.auto")
+	}
+
+	return err
+}
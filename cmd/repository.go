@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"github.com/MaximChernomorov/challenge-test/internal/repository"
+	"github.com/friendsofgo/errors"
+	"github.com/spf13/viper"
+)
+
+const (
+	repositoryPostgres = "postgres"
+	repositoryMemory   = "memory"
+)
+
+func init() {
+	viper.SetDefault("repository", repositoryPostgres)
+}
+
+// newRepo creates repository configured by `repository` config key, postgres repository is returned only if db
+// schema is up to date (see ensureSchema)
+func newRepo() (repository.Repository, error) {
+	switch viper.GetString("repository") {
+	case repositoryPostgres:
+		return newPostgresRepo()
+	case repositoryMemory:
+		return repository.NewMemoryRepo(), nil
+	default:
+		return nil, errors.Errorf("unknown repository %q", viper.GetString("repository"))
+	}
+}
+
+func newPostgresRepo() (repository.Repository, error) {
+	repo, err := repository.NewPostgresRepo(viper.GetString("psqURL"))
+	if err != nil {
+		return nil, err
+	}
+	err = ensureSchema(repo.(*repository.PostgresRepo).DB())
+	if err != nil {
+		_ = repo.Close()
+		return nil, err
+	}
+
+	return repo, nil
+}
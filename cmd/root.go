@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -34,7 +35,8 @@ func initConfig() {
 	viper.AutomaticEnv()
 
 	if err := viper.ReadInConfig(); err == nil {
-		fmt.Printf("using config file: %s\n", viper.ConfigFileUsed())
+		// stderr keeps stdout clean for json report and export written to stdout
+		fmt.Fprintf(os.Stderr, "using config file: %s\n", viper.ConfigFileUsed())
 	} else {
 		panic(err)
 	}
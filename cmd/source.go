@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	importerPkg "github.com/MaximChernomorov/challenge-test/pkg/importer"
+	"github.com/friendsofgo/errors"
+)
+
+const (
+	// stdinPath reads import source from stdin
+	stdinPath = "-"
+	globMeta  = "*?["
+)
+
+// expandSourcePaths expands globs in paths, glob which matches nothing is an error as it is likely a typo
+func expandSourcePaths(paths []string) ([]string, error) {
+	expanded := make([]string, 0, len(paths))
+	stdinUsed := false
+	for _, path := range paths {
+		if path == stdinPath {
+			if stdinUsed {
+				return nil, errors.New("stdin could be imported only once")
+			}
+			stdinUsed = true
+			expanded = append(expanded, path)
+			continue
+		}
+		matches, err := filepath.Glob(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid file path %s", path)
+		}
+		if len(matches) == 0 {
+			if strings.ContainsAny(path, globMeta) {
+				return nil, errors.Errorf("no files match %s", path)
+			}
+			// plain path, missing file is reported on opening
+			matches = []string{path}
+		}
+		expanded = append(expanded, matches...)
+	}
+
+	return expanded, nil
+}
+
+// openSource opens file or stdin and transparently decompresses it, raw source bytes are written to checksum as they
+// are read if it is set
+func openSource(path string, checksum io.Writer) (*sourceReader, error) {
+	var file io.ReadCloser = os.Stdin
+	if path != stdinPath {
+		var err error
+		file, err = os.Open(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open source")
+		}
+	}
+	var raw io.Reader = file
+	if checksum != nil {
+		raw = io.TeeReader(file, checksum)
+	}
+	reader, err := importerPkg.Decompress(raw)
+	if err != nil {
+		_ = file.Close()
+		return nil, errors.Wrapf(err, "failed to read %s", path)
+	}
+
+	return &sourceReader{ReadCloser: reader, file: file, raw: raw}, nil
+}
+
+// sourceReader closes both decompressor and file
+type sourceReader struct {
+	io.ReadCloser
+	file io.Closer
+	raw  io.Reader
+	// drained is set once decompressor is closed by drain
+	drained bool
+}
+
+// drain closes decompressor and reads the rest of raw source, so checksum covers the whole source even if importer
+// or decompressor stop before its end. Decompressor is closed first as it could read source in background
+func (reader *sourceReader) drain() error {
+	reader.drained = true
+	err := reader.ReadCloser.Close()
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(io.Discard, reader.raw)
+
+	return err
+}
+
+func (reader *sourceReader) Close() error {
+	var err error
+	if !reader.drained {
+		err = reader.ReadCloser.Close()
+	}
+	fileErr := reader.file.Close()
+	if err != nil {
+		return err
+	}
+
+	return fileErr
+}
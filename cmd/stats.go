@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository"
+	"github.com/friendsofgo/errors"
+	"github.com/spf13/cobra"
+)
+
+const defaultCityStatsLimit = 20
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "summarize the active dataset",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		withRepo(printStats)
+	},
+}
+
+var statsCountriesCmd = &cobra.Command{
+	Use:   "countries",
+	Short: "summarize the active dataset by country",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		withRepo(printCountryStats)
+	},
+}
+
+var statsCitiesCmd = &cobra.Command{
+	Use:   "cities",
+	Short: "list cities with the most records",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		countryCode, err := cmd.Flags().GetString("country")
+		cobra.CheckErr(err)
+		limit, err := getLimitFlag(cmd)
+		cobra.CheckErr(err)
+		withRepo(func(ctx context.Context, repo repository.Repository) {
+			printCityStats(ctx, repo, countryCode, limit)
+		})
+	},
+}
+
+func init() {
+	statsCitiesCmd.Flags().String("country", "", "country code to list cities of, all countries by default")
+	statsCitiesCmd.Flags().Int("limit", defaultCityStatsLimit, "max count of cities")
+	statsCmd.AddCommand(statsCountriesCmd, statsCitiesCmd)
+	rootCmd.AddCommand(statsCmd)
+}
+
+// getLimitFlag returns value of positive --limit flag
+func getLimitFlag(cmd *cobra.Command) (int, error) {
+	limit, err := cmd.Flags().GetInt("limit")
+	if err != nil {
+		return 0, err
+	}
+	if limit < 1 {
+		return 0, errors.New("invalid limit, must be positive integer")
+	}
+
+	return limit, nil
+}
+
+func printStats(ctx context.Context, repo repository.Repository) {
+	stats, err := repo.Stats(ctx)
+	cobra.CheckErr(err)
+
+	newestCreatedAt := "-"
+	if stats.NewestCreatedAt.Valid {
+		newestCreatedAt = stats.NewestCreatedAt.Time.Format(time.RFC3339)
+	}
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(writer, "DATASET\t%d\n", stats.DatasetID)
+	fmt.Fprintf(writer, "RECORDS\t%d\n", stats.Records)
+	fmt.Fprintf(writer, "COUNTRIES\t%d\n", stats.Countries)
+	fmt.Fprintf(writer, "CITIES\t%d\n", stats.Cities)
+	fmt.Fprintf(writer, "NEWEST\t%s\n", newestCreatedAt)
+	cobra.CheckErr(writer.Flush())
+}
+
+func printCountryStats(ctx context.Context, repo repository.Repository) {
+	countries, err := repo.CountryStats(ctx)
+	cobra.CheckErr(err)
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "CODE\tNAME\tRECORDS\tCITIES\tMIN LAT\tMIN LON\tMAX LAT\tMAX LON")
+	for _, country := range countries {
+		fmt.Fprintf(
+			writer,
+			"%s\t%s\t%d\t%d\t%g\t%g\t%g\t%g\n",
+			valueOrDash(country.Code),
+			valueOrDash(country.Name),
+			country.Records,
+			country.Cities,
+			country.MinLatitude,
+			country.MinLongitude,
+			country.MaxLatitude,
+			country.MaxLongitude,
+		)
+	}
+	cobra.CheckErr(writer.Flush())
+}
+
+func printCityStats(ctx context.Context, repo repository.Repository, countryCode string, limit int) {
+	cities, err := repo.CityStats(ctx, countryCode, limit)
+	cobra.CheckErr(err)
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "COUNTRY\tCITY\tRECORDS")
+	for _, city := range cities {
+		fmt.Fprintf(writer, "%s\t%s\t%d\n", valueOrDash(city.CountryCode), city.City, city.Records)
+	}
+	cobra.CheckErr(writer.Flush())
+}
+
+// valueOrDash makes empty table cells visible
+func valueOrDash(value string) string {
+	if len(value) == 0 {
+		return "-"
+	}
+
+	return value
+}
@@ -5,7 +5,16 @@ import (
 )
 
 type API struct {
-	repo repository.Repository
+	repo       repository.Repository
+	batchLimit int
+	// nearestLimit is max count of records in nearest lookup response
+	nearestLimit int
+	// searchLimit is max count of records in search response page
+	searchLimit int
+	cache       cacheStatsProvider
+	imports     importJobs
+	// importOptions configures import jobs endpoints
+	importOptions ImportJobsOptions
 }
 
 type ErrorResponse struct {
@@ -15,3 +24,29 @@ type ErrorResponse struct {
 func (api *API) SetRepo(repo repository.Repository) {
 	api.repo = repo
 }
+
+// SetBatchLimit sets max count of IP addresses in batch lookup request
+func (api *API) SetBatchLimit(batchLimit int) {
+	api.batchLimit = batchLimit
+}
+
+// SetNearestLimit sets max count of records in nearest lookup response
+func (api *API) SetNearestLimit(nearestLimit int) {
+	api.nearestLimit = nearestLimit
+}
+
+// SetSearchLimit sets max count of records in search response page
+func (api *API) SetSearchLimit(searchLimit int) {
+	api.searchLimit = searchLimit
+}
+
+// SetCache enables lookup cache stats endpoint
+func (api *API) SetCache(cache cacheStatsProvider) {
+	api.cache = cache
+}
+
+// SetImportJobs enables import jobs endpoints
+func (api *API) SetImportJobs(imports importJobs, options ImportJobsOptions) {
+	api.imports = imports
+	api.importOptions = options
+}
@@ -0,0 +1,27 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository"
+	"github.com/labstack/echo/v4"
+)
+
+// cacheStatsProvider is implemented by repository.CachedRepo
+type cacheStatsProvider interface {
+	CacheStats() repository.CacheStats
+}
+
+type cacheStatsResponse struct {
+	ErrorResponse
+	repository.CacheStats
+}
+
+// CacheStats echo http handler, it reports lookup cache hits and misses
+func (api *API) CacheStats(c echo.Context) error {
+	if api.cache == nil {
+		return c.JSON(http.StatusNotFound, cacheStatsResponse{ErrorResponse: ErrorResponse{Error: "cache is disabled"}})
+	}
+
+	return c.JSON(http.StatusOK, cacheStatsResponse{CacheStats: api.cache.CacheStats()})
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/friendsofgo/errors"
+	"github.com/labstack/echo/v4"
+)
+
+// NewIPExtractor returns extractor of the caller IP address which honours X-Forwarded-For and X-Real-IP headers only
+// if request came from one of trusted proxies. Proxies are IP addresses or CIDR networks
+func NewIPExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	networks := make([]*net.IPNet, 0, len(trustedProxies))
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, proxy := range trustedProxies {
+		network, err := parseProxyNetwork(proxy)
+		if err != nil {
+			return nil, err
+		}
+		networks = append(networks, network)
+		options = append(options, echo.TrustIPRange(network))
+	}
+	extractFromXFF := echo.ExtractIPFromXFFHeader(options...)
+
+	return func(req *http.Request) string {
+		if len(req.Header.Get(echo.HeaderXForwardedFor)) != 0 {
+			return extractFromXFF(req)
+		}
+		directIP := extractDirectIP(req)
+		realIP := strings.TrimSpace(req.Header.Get(echo.HeaderXRealIP))
+		if len(realIP) == 0 || net.ParseIP(realIP) == nil || !containsIP(networks, net.ParseIP(directIP)) {
+			return directIP
+		}
+
+		return realIP
+	}, nil
+}
+
+func parseProxyNetwork(proxy string) (*net.IPNet, error) {
+	if strings.Contains(proxy, "/") {
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid trusted proxy %s", proxy)
+		}
+		return network, nil
+	}
+	ip := net.ParseIP(proxy)
+	if ip == nil {
+		return nil, errors.Errorf("invalid trusted proxy %s", proxy)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	bits := len(ip) * 8
+
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
+
+func extractDirectIP(req *http.Request) string {
+	ip, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+
+	return ip
+}
+
+func containsIP(networks []*net.IPNet, ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIPExtractor(t *testing.T) {
+	type args struct {
+		remoteAddr string
+		xff        string
+		realIP     string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		expected string
+	}{
+		{
+			name:     "direct",
+			args:     args{remoteAddr: "33.173.188.44:1234"},
+			expected: "33.173.188.44",
+		},
+		{
+			name:     "xff from untrusted",
+			args:     args{remoteAddr: "33.173.188.44:1234", xff: "200.106.141.15"},
+			expected: "33.173.188.44",
+		},
+		{
+			name:     "real ip from untrusted",
+			args:     args{remoteAddr: "33.173.188.44:1234", realIP: "200.106.141.15"},
+			expected: "33.173.188.44",
+		},
+		{
+			name:     "loopback is not trusted by default",
+			args:     args{remoteAddr: "127.0.0.1:1234", realIP: "200.106.141.15"},
+			expected: "127.0.0.1",
+		},
+		{
+			name:     "xff from trusted network",
+			args:     args{remoteAddr: "10.0.0.5:1234", xff: "200.106.141.15"},
+			expected: "200.106.141.15",
+		},
+		{
+			name:     "xff chain skips trusted proxies only",
+			args:     args{remoteAddr: "10.0.0.5:1234", xff: "1.1.1.1, 200.106.141.15, 192.168.1.1"},
+			expected: "200.106.141.15",
+		},
+		{
+			name:     "real ip from trusted single proxy",
+			args:     args{remoteAddr: "192.168.1.1:1234", realIP: "200.106.141.15"},
+			expected: "200.106.141.15",
+		},
+		{
+			name:     "invalid real ip from trusted proxy",
+			args:     args{remoteAddr: "192.168.1.1:1234", realIP: "unknown"},
+			expected: "192.168.1.1",
+		},
+	}
+	extractor, err := NewIPExtractor([]string{"10.0.0.0/8", "192.168.1.1"})
+	require.Nil(t, err)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/api/ip/me", nil)
+			req.RemoteAddr = tt.args.remoteAddr
+			if len(tt.args.xff) != 0 {
+				req.Header.Set(echo.HeaderXForwardedFor, tt.args.xff)
+			}
+			if len(tt.args.realIP) != 0 {
+				req.Header.Set(echo.HeaderXRealIP, tt.args.realIP)
+			}
+			require.Equal(t, tt.expected, extractor(req))
+		})
+	}
+}
+
+func TestNewIPExtractor_InvalidProxy(t *testing.T) {
+	_, err := NewIPExtractor([]string{"10.0.0.0/33"})
+	require.NotNil(t, err)
+	_, err = NewIPExtractor([]string{"proxy"})
+	require.NotNil(t, err)
+}
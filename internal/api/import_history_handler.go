@@ -0,0 +1,38 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultImportHistoryLimit = 20
+	maxImportHistoryLimit     = 1000
+)
+
+type importHistoryResponse struct {
+	ErrorResponse
+	Results []repository.ImportRun `json:"results,omitempty"`
+}
+
+// ImportHistory echo http handler, it returns recorded import runs, the newest first. Unlike import jobs, import runs
+// are stored in db, so runs of import command and of api before restart are returned too
+func (api *API) ImportHistory(c echo.Context) error {
+	response := importHistoryResponse{}
+	limit, err := readLimit(c, defaultImportHistoryLimit, maxImportHistoryLimit)
+	if err != nil {
+		response.Error = err.Error()
+		return c.JSON(http.StatusBadRequest, response)
+	}
+
+	response.Results, err = api.repo.ListImports(c.Request().Context(), limit)
+	if err != nil {
+		c.Logger().Error(err)
+		response.Error = "failed to get import history"
+		return c.JSON(http.StatusInternalServerError, response)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,239 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/MaximChernomorov/challenge-test/internal/importjob"
+	"github.com/friendsofgo/errors"
+	"github.com/labstack/echo/v4"
+)
+
+// importJobs is implemented by importjob.Manager
+type importJobs interface {
+	Check(options importjob.Options) error
+	Submit(source importjob.Source, options importjob.Options) (importjob.Job, error)
+	Get(id int) (importjob.Job, error)
+}
+
+// ImportJobsOptions configures import jobs endpoints
+type ImportJobsOptions struct {
+	// SourceDir is directory of server-side files which could be imported by path, empty value disables import by
+	// path
+	SourceDir string
+	// UploadDir is directory uploaded files are stored in until they are imported, empty value means default
+	// directory for temporary files
+	UploadDir string
+}
+
+// maxImportFormFieldSize limits size of import form fields other than uploaded file
+const maxImportFormFieldSize = 1 << 10
+
+// SubmitImport echo http handler, it queues import of multipart uploaded file or of server-side file by path. Import
+// options are passed as form fields too
+func (api *API) SubmitImport(c echo.Context) error {
+	if api.imports == nil {
+		return c.JSON(http.StatusNotFound, ErrorResponse{Error: "import jobs are disabled"})
+	}
+	form, err := api.readImportJobForm(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+	}
+	source, err := api.importJobSource(form)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+	}
+	options, err := readImportJobOptions(form)
+	if err == nil {
+		err = api.imports.Check(options)
+	}
+	if err != nil {
+		removeUpload(source)
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+	}
+
+	job, err := api.imports.Submit(source, options)
+	if errors.Is(err, importjob.ErrQueueFull) {
+		return c.JSON(http.StatusServiceUnavailable, ErrorResponse{Error: err.Error()})
+	}
+	if err != nil {
+		c.Logger().Error(err)
+		return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "failed to submit import"})
+	}
+	c.Response().Header().Set(echo.HeaderLocation, fmt.Sprintf("%s/%d", c.Request().URL.Path, job.ID))
+
+	return c.JSON(http.StatusAccepted, job)
+}
+
+// GetImport echo http handler, it returns import job status, progress and result. Error of failed job is returned
+// in error field too
+func (api *API) GetImport(c echo.Context) error {
+	if api.imports == nil {
+		return c.JSON(http.StatusNotFound, ErrorResponse{Error: "import jobs are disabled"})
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid job id"})
+	}
+	job, err := api.imports.Get(id)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, ErrorResponse{Error: "import job not found"})
+	}
+
+	return c.JSON(http.StatusOK, job)
+}
+
+// importJobForm is submitted import form, uploaded file is already stored in upload directory
+type importJobForm struct {
+	values url.Values
+	upload *importjob.Source
+}
+
+// readImportJobForm reads import form. Multipart parts are read one by one and uploaded file is streamed into upload
+// directory, so it is stored on disk once whatever its size is
+func (api *API) readImportJobForm(c echo.Context) (importJobForm, error) {
+	form := importJobForm{values: url.Values{}}
+	reader, err := c.Request().MultipartReader()
+	if errors.Is(err, http.ErrNotMultipart) {
+		form.values, err = c.FormParams()
+		if err != nil {
+			return form, errors.New("failed to read form")
+		}
+		return form, nil
+	}
+	if err != nil {
+		return form, errors.New("failed to read multipart form")
+	}
+	for {
+		part, err := reader.NextPart()
+		switch {
+		case err == io.EOF:
+			return form, nil
+		case err != nil:
+			err = errors.New("failed to read multipart form")
+		case part.FormName() == "file" && form.upload != nil:
+			err = errors.New("only one file could be uploaded")
+		case part.FormName() == "file":
+			form.upload, err = api.storeUpload(c, part)
+		default:
+			err = readImportFormField(form.values, part)
+		}
+		if err != nil {
+			if form.upload != nil {
+				removeUpload(*form.upload)
+			}
+			return form, err
+		}
+	}
+}
+
+// readImportFormField adds value of form field part to values
+func readImportFormField(values url.Values, part *multipart.Part) error {
+	value, err := io.ReadAll(io.LimitReader(part, maxImportFormFieldSize+1))
+	if err != nil {
+		return errors.New("failed to read multipart form")
+	}
+	if len(value) > maxImportFormFieldSize {
+		return errors.Errorf("%s is too long", part.FormName())
+	}
+	values.Add(part.FormName(), string(value))
+
+	return nil
+}
+
+func readImportJobOptions(form importJobForm) (importjob.Options, error) {
+	options := importjob.Options{
+		Format:     formValue(form, "format", "csv"),
+		Mode:       formValue(form, "mode", "append"),
+		Duplicates: formValue(form, "duplicates", "first-wins"),
+		Promote:    true,
+	}
+	for field, value := range map[string]*bool{
+		"delete_missing": &options.DeleteMissing,
+		"promote":        &options.Promote,
+	} {
+		if len(form.values.Get(field)) == 0 {
+			continue
+		}
+		var err error
+		*value, err = strconv.ParseBool(form.values.Get(field))
+		if err != nil {
+			return options, errors.Errorf("invalid %s, must be boolean", field)
+		}
+	}
+
+	return options, nil
+}
+
+// importJobSource returns uploaded file or resolves path of server-side file inside of source directory. Symlinks
+// are resolved before the check, so they can't point outside of it
+func (api *API) importJobSource(form importJobForm) (importjob.Source, error) {
+	if form.upload != nil {
+		return *form.upload, nil
+	}
+	path := form.values.Get("path")
+	if len(path) == 0 {
+		return importjob.Source{}, errors.New("file or path is required")
+	}
+	if len(api.importOptions.SourceDir) == 0 {
+		return importjob.Source{}, errors.New("import by path is disabled")
+	}
+	sourceDir, err := filepath.EvalSymlinks(api.importOptions.SourceDir)
+	if err != nil {
+		return importjob.Source{}, errors.New("source directory not found")
+	}
+	fullPath, err := filepath.EvalSymlinks(filepath.Join(sourceDir, path))
+	if err != nil {
+		return importjob.Source{}, errors.Errorf("file %s not found", path)
+	}
+	relativePath, err := filepath.Rel(sourceDir, fullPath)
+	if err != nil || relativePath == ".." || strings.HasPrefix(relativePath, ".."+string(filepath.Separator)) {
+		return importjob.Source{}, errors.New("path is outside of source directory")
+	}
+	info, err := os.Stat(fullPath)
+	if err != nil || !info.Mode().IsRegular() {
+		return importjob.Source{}, errors.Errorf("file %s not found", path)
+	}
+
+	return importjob.Source{Path: fullPath, Name: path}, nil
+}
+
+// storeUpload streams uploaded file into upload directory, it is removed once import job is finished
+func (api *API) storeUpload(c echo.Context, part *multipart.Part) (*importjob.Source, error) {
+	file, err := os.CreateTemp(api.importOptions.UploadDir, "import-*")
+	if err != nil {
+		c.Logger().Error(err)
+		return nil, errors.New("failed to store uploaded file")
+	}
+	_, err = io.Copy(file, part)
+	closeErr := file.Close()
+	if err != nil || closeErr != nil {
+		_ = os.Remove(file.Name())
+		return nil, errors.New("failed to store uploaded file")
+	}
+
+	return &importjob.Source{Path: file.Name(), Name: part.FileName(), Temporary: true}, nil
+}
+
+// removeUpload removes uploaded file of source which isn't submitted
+func removeUpload(source importjob.Source) {
+	if source.Temporary {
+		_ = os.Remove(source.Path)
+	}
+}
+
+// formValue returns form field value or fallback if it is empty
+func formValue(form importJobForm, field, fallback string) string {
+	if value := form.values.Get(field); len(value) != 0 {
+		return value
+	}
+
+	return fallback
+}
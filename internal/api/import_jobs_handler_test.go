@@ -0,0 +1,136 @@
+package api
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/MaximChernomorov/challenge-test/internal/importjob"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// submittedJobs records submitted sources and options
+type submittedJobs struct {
+	sources  []importjob.Source
+	contents []string
+	options  []importjob.Options
+}
+
+func (jobs *submittedJobs) Check(options importjob.Options) error {
+	return nil
+}
+
+func (jobs *submittedJobs) Submit(source importjob.Source, options importjob.Options) (importjob.Job, error) {
+	content, err := os.ReadFile(source.Path)
+	if err != nil {
+		return importjob.Job{}, err
+	}
+	jobs.sources = append(jobs.sources, source)
+	jobs.contents = append(jobs.contents, string(content))
+	jobs.options = append(jobs.options, options)
+
+	return importjob.Job{ID: len(jobs.sources)}, nil
+}
+
+func (jobs *submittedJobs) Get(id int) (importjob.Job, error) {
+	return importjob.Job{ID: id}, nil
+}
+
+func submitImport(t *testing.T, api *API, request *http.Request) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	require.Nil(t, api.SubmitImport(echo.New().NewContext(request, recorder)))
+
+	return recorder
+}
+
+func TestAPI_SubmitImportUpload(t *testing.T) {
+	uploadDir := t.TempDir()
+	jobs := &submittedJobs{}
+	api := &API{}
+	api.SetImportJobs(jobs, ImportJobsOptions{UploadDir: uploadDir})
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	file, err := writer.CreateFormFile("file", "data_dump.csv")
+	require.Nil(t, err)
+	_, err = file.Write([]byte("ip_address,country_code\n"))
+	require.Nil(t, err)
+	// options could follow the file
+	require.Nil(t, writer.WriteField("mode", "merge"))
+	require.Nil(t, writer.Close())
+	request := httptest.NewRequest(http.MethodPost, "/api/admin/imports", body)
+	request.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+
+	recorder := submitImport(t, api, request)
+	require.Equal(t, http.StatusAccepted, recorder.Code, recorder.Body.String())
+	require.Len(t, jobs.sources, 1)
+	require.Equal(t, "data_dump.csv", jobs.sources[0].Name)
+	require.True(t, jobs.sources[0].Temporary)
+	require.Equal(t, uploadDir, filepath.Dir(jobs.sources[0].Path))
+	require.Equal(t, "ip_address,country_code\n", jobs.contents[0])
+	require.Equal(t, "merge", jobs.options[0].Mode)
+
+	// upload of rejected submission is removed
+	body.Reset()
+	writer = multipart.NewWriter(body)
+	_, err = writer.CreateFormFile("file", "data_dump.csv")
+	require.Nil(t, err)
+	require.Nil(t, writer.WriteField("promote", "maybe"))
+	require.Nil(t, writer.Close())
+	request = httptest.NewRequest(http.MethodPost, "/api/admin/imports", body)
+	request.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+
+	recorder = submitImport(t, api, request)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	stored, err := os.ReadDir(uploadDir)
+	require.Nil(t, err)
+	require.Len(t, stored, 1)
+}
+
+func TestAPI_SubmitImportPath(t *testing.T) {
+	root := t.TempDir()
+	sourceDir := filepath.Join(root, "sources")
+	require.Nil(t, os.Mkdir(sourceDir, 0o755))
+	require.Nil(t, os.WriteFile(filepath.Join(sourceDir, "data_dump.csv"), []byte("inside"), 0o600))
+	require.Nil(t, os.WriteFile(filepath.Join(root, "secret.csv"), []byte("outside"), 0o600))
+	require.Nil(t, os.Symlink(filepath.Join(root, "secret.csv"), filepath.Join(sourceDir, "escape.csv")))
+	require.Nil(t, os.Symlink("data_dump.csv", filepath.Join(sourceDir, "link.csv")))
+
+	tests := []struct {
+		name    string
+		path    string
+		status  int
+		content string
+	}{
+		{name: "file", path: "data_dump.csv", status: http.StatusAccepted, content: "inside"},
+		{name: "symlink inside source directory", path: "link.csv", status: http.StatusAccepted, content: "inside"},
+		{name: "symlink outside source directory", path: "escape.csv", status: http.StatusBadRequest},
+		{name: "relative path outside source directory", path: "../secret.csv", status: http.StatusBadRequest},
+		{name: "missing file", path: "missing.csv", status: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobs := &submittedJobs{}
+			api := &API{}
+			api.SetImportJobs(jobs, ImportJobsOptions{SourceDir: sourceDir})
+			request := httptest.NewRequest(
+				http.MethodPost,
+				"/api/admin/imports",
+				strings.NewReader("path="+tt.path),
+			)
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+
+			recorder := submitImport(t, api, request)
+			require.Equal(t, tt.status, recorder.Code, recorder.Body.String())
+			if tt.status == http.StatusAccepted {
+				require.Equal(t, []string{tt.content}, jobs.contents)
+			}
+		})
+	}
+}
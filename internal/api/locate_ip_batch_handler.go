@@ -0,0 +1,89 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/friendsofgo/errors"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	batchStatusFound    = "found"
+	batchStatusNotFound = "not_found"
+	batchStatusInvalid  = "invalid"
+)
+
+type ipLocationBatchRequest struct {
+	IPAddresses []string `json:"ip_addresses"`
+}
+
+type ipLocationBatchResponse struct {
+	ErrorResponse
+	Results []ipLocationBatchResult `json:"results,omitempty"`
+}
+
+type ipLocationBatchResult struct {
+	IPAddress string `json:"ip_address"`
+	Status    string `json:"status"`
+	location
+}
+
+// LocateIPBatch echo http handler, locates all requested IP addresses with single repository call
+func (api *API) LocateIPBatch(c echo.Context) error {
+	response := ipLocationBatchResponse{}
+	request, err := readIPLocationBatchRequest(c.Request().Body, api.batchLimit)
+	if err != nil {
+		response.Error = err.Error()
+		return c.JSON(http.StatusBadRequest, response)
+	}
+
+	validIPs := make([]string, 0, len(request.IPAddresses))
+	uniquenessMap := make(map[string]struct{}, len(request.IPAddresses))
+	for _, ip := range request.IPAddresses {
+		if _, exists := uniquenessMap[ip]; exists || net.ParseIP(ip) == nil {
+			continue
+		}
+		uniquenessMap[ip] = struct{}{}
+		validIPs = append(validIPs, ip)
+	}
+	geoLocations, err := api.repo.LocateIPs(c.Request().Context(), validIPs)
+	if err != nil {
+		c.Logger().Error(err)
+		response.Error = "failed to locate IP addresses"
+		return c.JSON(http.StatusInternalServerError, response)
+	}
+
+	response.Results = make([]ipLocationBatchResult, 0, len(request.IPAddresses))
+	for _, ip := range request.IPAddresses {
+		result := ipLocationBatchResult{IPAddress: ip, Status: batchStatusInvalid}
+		if net.ParseIP(ip) != nil {
+			result.Status = batchStatusNotFound
+		}
+		if geoLocation, found := geoLocations[ip]; found {
+			result.Status = batchStatusFound
+			result.location = getLocation(geoLocation)
+		}
+		response.Results = append(response.Results, result)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func readIPLocationBatchRequest(rc io.ReadCloser, limit int) (ipLocationBatchRequest, error) {
+	request := ipLocationBatchRequest{}
+	err := json.NewDecoder(rc).Decode(&request)
+	if err != nil {
+		return request, errors.Wrap(err, "invalid json")
+	}
+	if len(request.IPAddresses) == 0 {
+		return request, errors.New("no IP addresses")
+	}
+	if limit > 0 && len(request.IPAddresses) > limit {
+		return request, errors.Errorf("too many IP addresses, max %d", limit)
+	}
+
+	return request, nil
+}
@@ -6,15 +6,26 @@ import (
 	"net"
 	"net/http"
 
+	"github.com/MaximChernomorov/challenge-test/internal/repository"
 	"github.com/friendsofgo/errors"
 	"github.com/labstack/echo/v4"
 )
 
 type ipLocationResponse struct {
 	ErrorResponse
-	Country     string `json:"country,omitempty"`
-	City        string `json:"city,omitempty"`
-	Coordinates struct {
+	location
+}
+
+// location country fields are taken from countries reference table if geolocation refers to a country, otherwise
+// country and country code are returned as imported
+type location struct {
+	Country        string `json:"country,omitempty"`
+	CountryCode    string `json:"country_code,omitempty"`
+	CountryAlpha3  string `json:"country_alpha3,omitempty"`
+	CountryNumeric string `json:"country_numeric,omitempty"`
+	Continent      string `json:"continent,omitempty"`
+	City           string `json:"city,omitempty"`
+	Coordinates    struct {
 		Longitude float64 `json:"longitude,omitempty"`
 		Latitude  float64 `json:"latitude,omitempty"`
 	} `json:"coordinates,omitempty"`
@@ -26,25 +37,70 @@ type ipLocationRequest struct {
 
 // LocateIP echo http handler
 func (api *API) LocateIP(c echo.Context) error {
-	response := ipLocationResponse{}
 	request, err := readIPLocationRequest(c.Request().Body)
 	if err != nil {
-		response.Error = err.Error()
-		return c.JSON(http.StatusBadRequest, response)
+		return c.JSON(http.StatusBadRequest, ipLocationResponse{ErrorResponse: ErrorResponse{Error: err.Error()}})
 	}
-	geoLocation, err := api.repo.LocateIP(c.Request().Context(), request.IPAddress)
-	if err != nil {
+
+	return api.locateIP(c, request.IPAddress)
+}
+
+// LocateIPByPath echo http handler for IP address passed as path parameter
+func (api *API) LocateIPByPath(c echo.Context) error {
+	ip := c.Param("ip")
+	if net.ParseIP(ip) == nil {
+		return c.JSON(http.StatusBadRequest, ipLocationResponse{ErrorResponse: ErrorResponse{Error: "invalid IP address"}})
+	}
+
+	return api.locateIP(c, ip)
+}
+
+// LocateCallerIP echo http handler for the caller IP address, proxy headers are honoured only from trusted proxies
+// (see NewIPExtractor)
+func (api *API) LocateCallerIP(c echo.Context) error {
+	ip := c.RealIP()
+	if net.ParseIP(ip) == nil {
+		return c.JSON(http.StatusBadRequest, ipLocationResponse{ErrorResponse: ErrorResponse{Error: "invalid IP address"}})
+	}
+
+	return api.locateIP(c, ip)
+}
+
+// locateIP writes location of valid IP address as response
+func (api *API) locateIP(c echo.Context, ip string) error {
+	response := ipLocationResponse{}
+	geoLocation, err := api.repo.LocateIP(c.Request().Context(), ip)
+	if errors.Is(err, repository.ErrLocationNotFound) {
 		response.Error = "location not found"
 		return c.JSON(http.StatusNotFound, response)
 	}
-	response.City = geoLocation.City.String
-	response.Country = geoLocation.Country.String
-	response.Coordinates.Latitude = geoLocation.Coordinates.Y
-	response.Coordinates.Longitude = geoLocation.Coordinates.X
+	if err != nil {
+		c.Logger().Error(err)
+		response.Error = "failed to locate IP address"
+		return c.JSON(http.StatusInternalServerError, response)
+	}
+	response.location = getLocation(geoLocation)
 
 	return c.JSON(http.StatusOK, response)
 }
 
+func getLocation(geoLocation repository.Geolocation) location {
+	result := location{}
+	result.City = geoLocation.City.String
+	result.Country = geoLocation.Country.String
+	result.CountryCode = geoLocation.CountryCode.String
+	if country := geoLocation.CountryRef; country != nil {
+		result.Country = country.Name
+		result.CountryCode = country.Code
+		result.CountryAlpha3 = country.Alpha3
+		result.CountryNumeric = country.Numeric
+		result.Continent = country.Continent
+	}
+	result.Coordinates.Latitude, result.Coordinates.Longitude = geoLocation.LatLon()
+
+	return result
+}
+
 func readIPLocationRequest(rc io.ReadCloser) (ipLocationRequest, error) {
 	request := ipLocationRequest{}
 	err := json.NewDecoder(rc).Decode(&request)
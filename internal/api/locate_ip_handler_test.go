@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository"
+	"github.com/friendsofgo/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// failingRepo fails every IP lookup with err
+type failingRepo struct {
+	repository.Repository
+	err error
+}
+
+func (repo failingRepo) LocateIP(ctx context.Context, ip string) (repository.Geolocation, error) {
+	return repository.Geolocation{}, repo.err
+}
+
+func TestAPI_LocateIPByPath(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "not found", err: repository.ErrLocationNotFound, status: http.StatusNotFound},
+		{name: "repository failure", err: errors.New("connection refused"), status: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := API{}
+			api.SetRepo(failingRepo{err: tt.err})
+			recorder := httptest.NewRecorder()
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/api/ip/1.1.1.1", nil), recorder)
+			c.SetParamNames("ip")
+			c.SetParamValues("1.1.1.1")
+
+			require.Nil(t, api.LocateIPByPath(c))
+			require.Equal(t, tt.status, recorder.Code)
+		})
+	}
+}
+
+func TestAPI_LocateCallerIP(t *testing.T) {
+	tests := []struct {
+		name       string
+		remoteAddr string
+		realIP     string
+		status     int
+	}{
+		{name: "direct IP", remoteAddr: "1.1.1.1:1234", status: http.StatusNotFound},
+		{name: "malformed direct IP", remoteAddr: "not-an-ip", status: http.StatusBadRequest},
+		{name: "malformed header IP", remoteAddr: "1.1.1.1:1234", realIP: "1.1.1", status: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := API{}
+			api.SetRepo(failingRepo{err: repository.ErrLocationNotFound})
+			request := httptest.NewRequest(http.MethodGet, "/api/ip/me", nil)
+			request.RemoteAddr = tt.remoteAddr
+			if len(tt.realIP) != 0 {
+				request.Header.Set(echo.HeaderXRealIP, tt.realIP)
+			}
+			recorder := httptest.NewRecorder()
+
+			require.Nil(t, api.LocateCallerIP(echo.New().NewContext(request, recorder)))
+			require.Equal(t, tt.status, recorder.Code)
+		})
+	}
+}
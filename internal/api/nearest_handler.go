@@ -0,0 +1,73 @@
+package api
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/friendsofgo/errors"
+	"github.com/labstack/echo/v4"
+)
+
+const defaultNearestLimit = 10
+
+type nearestRequest struct {
+	Latitude  float64
+	Longitude float64
+	Limit     int
+}
+
+type nearestResponse struct {
+	ErrorResponse
+	Results []nearestResult `json:"results,omitempty"`
+}
+
+type nearestResult struct {
+	IPAddress  string  `json:"ip_address"`
+	DistanceKm float64 `json:"distance_km"`
+	location
+}
+
+// Nearest echo http handler, returns known IP records closest to lat and lon query parameters, the closest first
+func (api *API) Nearest(c echo.Context) error {
+	response := nearestResponse{}
+	request, err := readNearestRequest(c, api.nearestLimit)
+	if err != nil {
+		response.Error = err.Error()
+		return c.JSON(http.StatusBadRequest, response)
+	}
+
+	nearby, err := api.repo.NearestGeolocations(c.Request().Context(), request.Latitude, request.Longitude, request.Limit)
+	if err != nil {
+		c.Logger().Error(err)
+		response.Error = "failed to find nearest locations"
+		return c.JSON(http.StatusInternalServerError, response)
+	}
+
+	response.Results = make([]nearestResult, 0, len(nearby))
+	for _, geoLocation := range nearby {
+		response.Results = append(response.Results, nearestResult{
+			IPAddress:  geoLocation.IPAddress,
+			DistanceKm: math.Round(geoLocation.DistanceKm*1000) / 1000,
+			location:   getLocation(geoLocation.Geolocation),
+		})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func readNearestRequest(c echo.Context, maxLimit int) (nearestRequest, error) {
+	request := nearestRequest{}
+	var err error
+	request.Latitude, err = strconv.ParseFloat(c.QueryParam("lat"), 64)
+	if err != nil || request.Latitude < -90 || request.Latitude > 90 {
+		return request, errors.New("invalid lat, must be between -90 and 90")
+	}
+	request.Longitude, err = strconv.ParseFloat(c.QueryParam("lon"), 64)
+	if err != nil || request.Longitude < -180 || request.Longitude > 180 {
+		return request, errors.New("invalid lon, must be between -180 and 180")
+	}
+	request.Limit, err = readLimit(c, defaultNearestLimit, maxLimit)
+
+	return request, err
+}
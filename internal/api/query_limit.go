@@ -0,0 +1,26 @@
+package api
+
+import (
+	"strconv"
+
+	"github.com/friendsofgo/errors"
+	"github.com/labstack/echo/v4"
+)
+
+// readLimit returns positive limit query parameter or fallback if it is missing. Limit is checked against maxLimit
+// unless maxLimit is zero
+func readLimit(c echo.Context, fallback, maxLimit int) (int, error) {
+	limit := fallback
+	if value := c.QueryParam("limit"); len(value) != 0 {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit < 1 {
+			return 0, errors.New("invalid limit, must be positive integer")
+		}
+	}
+	if maxLimit > 0 && limit > maxLimit {
+		return 0, errors.Errorf("too big limit, max %d", maxLimit)
+	}
+
+	return limit, nil
+}
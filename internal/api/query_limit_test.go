@@ -0,0 +1,38 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadLimit(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		maxLimit int
+		expected int
+		wantErr  bool
+	}{
+		{name: "fallback", query: "", maxLimit: 100, expected: 10},
+		{name: "limit", query: "?limit=100", maxLimit: 100, expected: 100},
+		{name: "no max limit", query: "?limit=1000", expected: 1000},
+		{name: "too big limit", query: "?limit=101", maxLimit: 100, wantErr: true},
+		{name: "fallback above max limit", query: "", maxLimit: 5, wantErr: true},
+		{name: "zero limit", query: "?limit=0", maxLimit: 100, wantErr: true},
+		{name: "invalid limit", query: "?limit=ten", maxLimit: 100, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, "/api/stats/cities"+tt.query, nil)
+			c := echo.New().NewContext(request, httptest.NewRecorder())
+
+			limit, err := readLimit(c, 10, tt.maxLimit)
+			require.Equal(t, tt.wantErr, err != nil)
+			require.Equal(t, tt.expected, limit)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository"
+	"github.com/friendsofgo/errors"
+	"github.com/labstack/echo/v4"
+)
+
+const defaultSearchLimit = 50
+
+type searchResponse struct {
+	ErrorResponse
+	Results    []searchResult `json:"results,omitempty"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+type searchResult struct {
+	IPAddress string `json:"ip_address"`
+	CreatedAt string `json:"created_at,omitempty"`
+	location
+}
+
+// SearchGeolocations echo http handler, returns page of IP records matching query parameters filters, the next page
+// is requested with next_cursor of the previous one and the same filters and sort
+func (api *API) SearchGeolocations(c echo.Context) error {
+	response := searchResponse{}
+	options, err := readSearchRequest(c, api.searchLimit)
+	if err != nil {
+		response.Error = err.Error()
+		return c.JSON(http.StatusBadRequest, response)
+	}
+
+	result, err := api.repo.SearchGeolocations(c.Request().Context(), options)
+	if errors.Is(err, repository.ErrInvalidCursor) {
+		response.Error = err.Error()
+		return c.JSON(http.StatusBadRequest, response)
+	}
+	if err != nil {
+		c.Logger().Error(err)
+		response.Error = "failed to search locations"
+		return c.JSON(http.StatusInternalServerError, response)
+	}
+
+	response.Results = make([]searchResult, 0, len(result.Geolocations))
+	for _, geoLocation := range result.Geolocations {
+		searchResult := searchResult{IPAddress: geoLocation.IPAddress, location: getLocation(geoLocation)}
+		if geoLocation.CreatedAt.Valid {
+			searchResult.CreatedAt = geoLocation.CreatedAt.Time.UTC().Format(time.RFC3339Nano)
+		}
+		response.Results = append(response.Results, searchResult)
+	}
+	response.NextCursor = result.NextCursor
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func readSearchRequest(c echo.Context, maxLimit int) (repository.SearchOptions, error) {
+	options := repository.SearchOptions{
+		Country:     c.QueryParam("country"),
+		CountryCode: c.QueryParam("country_code"),
+		City:        c.QueryParam("city"),
+		IPPrefix:    c.QueryParam("ip_prefix"),
+		Cursor:      c.QueryParam("cursor"),
+	}
+	var err error
+	if len(options.IPPrefix) != 0 && net.ParseIP(options.IPPrefix) == nil {
+		if _, _, err = net.ParseCIDR(options.IPPrefix); err != nil {
+			return options, errors.New("invalid ip_prefix, must be IP address or CIDR network")
+		}
+	}
+	for param, value := range map[string]*time.Time{
+		"created_from": &options.CreatedFrom,
+		"created_to":   &options.CreatedTo,
+	} {
+		if len(c.QueryParam(param)) == 0 {
+			continue
+		}
+		*value, err = time.Parse(time.RFC3339, c.QueryParam(param))
+		if err != nil {
+			return options, errors.Errorf("invalid %s, must be RFC 3339 time", param)
+		}
+	}
+	options.Sort, options.Descending, err = repository.ParseSearchSort(c.QueryParam("sort"))
+	if err != nil {
+		return options, err
+	}
+	options.Limit, err = readLimit(c, defaultSearchLimit, maxLimit)
+
+	return options, err
+}
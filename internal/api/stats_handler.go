@@ -0,0 +1,72 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository"
+	"github.com/labstack/echo/v4"
+)
+
+const defaultCityStatsLimit = 100
+
+type statsResponse struct {
+	ErrorResponse
+	repository.Stats
+}
+
+type countryStatsResponse struct {
+	ErrorResponse
+	Results []repository.CountryStats `json:"results,omitempty"`
+}
+
+type cityStatsResponse struct {
+	ErrorResponse
+	Results []repository.CityStats `json:"results,omitempty"`
+}
+
+// Stats echo http handler, it summarizes the active dataset
+func (api *API) Stats(c echo.Context) error {
+	response := statsResponse{}
+	var err error
+	response.Stats, err = api.repo.Stats(c.Request().Context())
+	if err != nil {
+		c.Logger().Error(err)
+		response.Error = "failed to get stats"
+		return c.JSON(http.StatusInternalServerError, response)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// CountryStats echo http handler, it summarizes the active dataset by country
+func (api *API) CountryStats(c echo.Context) error {
+	response := countryStatsResponse{}
+	var err error
+	response.Results, err = api.repo.CountryStats(c.Request().Context())
+	if err != nil {
+		c.Logger().Error(err)
+		response.Error = "failed to get country stats"
+		return c.JSON(http.StatusInternalServerError, response)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// CityStats echo http handler, it returns cities with the most records, optionally of country_code only
+func (api *API) CityStats(c echo.Context) error {
+	response := cityStatsResponse{}
+	limit, err := readLimit(c, defaultCityStatsLimit, repository.MaxCityStatsLimit)
+	if err != nil {
+		response.Error = err.Error()
+		return c.JSON(http.StatusBadRequest, response)
+	}
+
+	response.Results, err = api.repo.CityStats(c.Request().Context(), c.QueryParam("country_code"), limit)
+	if err != nil {
+		c.Logger().Error(err)
+		response.Error = "failed to get city stats"
+		return c.JSON(http.StatusInternalServerError, response)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
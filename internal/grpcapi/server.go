@@ -0,0 +1,165 @@
+// Package grpcapi implements geolocation.v1.GeolocationService on top of repository.Repository
+package grpcapi
+
+import (
+	"context"
+	"io"
+	"net"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository"
+	geolocationv1 "github.com/MaximChernomorov/challenge-test/pkg/pb/geolocation/v1"
+	"github.com/friendsofgo/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+type Server struct {
+	geolocationv1.UnimplementedGeolocationServiceServer
+	repo       repository.Repository
+	batchLimit int
+}
+
+// NewServer creates service, batchLimit limits count of IP addresses in LocateMany request, 0 means no limit
+func NewServer(repo repository.Repository, batchLimit int) *Server {
+	return &Server{repo: repo, batchLimit: batchLimit}
+}
+
+// Register registers service on grpcServer together with health checking and reflection, returned health server
+// should be switched to NOT_SERVING on shutdown
+func (server *Server) Register(grpcServer *grpc.Server) *health.Server {
+	geolocationv1.RegisterGeolocationServiceServer(grpcServer, server)
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(
+		geolocationv1.GeolocationService_ServiceDesc.ServiceName,
+		healthpb.HealthCheckResponse_SERVING,
+	)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	reflection.Register(grpcServer)
+
+	return healthServer
+}
+
+func (server *Server) Locate(
+	ctx context.Context,
+	request *geolocationv1.LocateRequest,
+) (*geolocationv1.LocateResponse, error) {
+	if net.ParseIP(request.GetIpAddress()) == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid IP address")
+	}
+	geoLocation, err := server.repo.LocateIP(ctx, request.GetIpAddress())
+	if errors.Is(err, repository.ErrLocationNotFound) {
+		return nil, status.Error(codes.NotFound, "location not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to locate IP address")
+	}
+
+	return &geolocationv1.LocateResponse{Location: getLocation(geoLocation)}, nil
+}
+
+// LocateMany locates all valid IP addresses with single repository call
+func (server *Server) LocateMany(
+	ctx context.Context,
+	request *geolocationv1.LocateManyRequest,
+) (*geolocationv1.LocateManyResponse, error) {
+	IPs := request.GetIpAddresses()
+	if len(IPs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no IP addresses")
+	}
+	if server.batchLimit > 0 && len(IPs) > server.batchLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "too many IP addresses, max %d", server.batchLimit)
+	}
+
+	validIPs := make([]string, 0, len(IPs))
+	uniquenessMap := make(map[string]struct{}, len(IPs))
+	for _, ip := range IPs {
+		if _, exists := uniquenessMap[ip]; exists || net.ParseIP(ip) == nil {
+			continue
+		}
+		uniquenessMap[ip] = struct{}{}
+		validIPs = append(validIPs, ip)
+	}
+	geoLocations, err := server.repo.LocateIPs(ctx, validIPs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to locate IP addresses")
+	}
+
+	response := &geolocationv1.LocateManyResponse{Results: make([]*geolocationv1.LocateResult, 0, len(IPs))}
+	for _, ip := range IPs {
+		result := &geolocationv1.LocateResult{IpAddress: ip, Status: geolocationv1.LocateStatus_LOCATE_STATUS_INVALID}
+		if net.ParseIP(ip) != nil {
+			result.Status = geolocationv1.LocateStatus_LOCATE_STATUS_NOT_FOUND
+		}
+		if geoLocation, found := geoLocations[ip]; found {
+			result.Status = geolocationv1.LocateStatus_LOCATE_STATUS_FOUND
+			result.Location = getLocation(geoLocation)
+		}
+		response.Results = append(response.Results, result)
+	}
+
+	return response, nil
+}
+
+// LocateStream answers every received IP address in order until client closes sending side
+func (server *Server) LocateStream(stream geolocationv1.GeolocationService_LocateStreamServer) error {
+	for {
+		request, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		result, err := server.locateResult(stream.Context(), request.GetIpAddress())
+		if err != nil {
+			return err
+		}
+		err = stream.Send(&geolocationv1.LocateStreamResponse{Result: result})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (server *Server) locateResult(ctx context.Context, ip string) (*geolocationv1.LocateResult, error) {
+	result := &geolocationv1.LocateResult{IpAddress: ip, Status: geolocationv1.LocateStatus_LOCATE_STATUS_INVALID}
+	if net.ParseIP(ip) == nil {
+		return result, nil
+	}
+	geoLocation, err := server.repo.LocateIP(ctx, ip)
+	if errors.Is(err, repository.ErrLocationNotFound) {
+		result.Status = geolocationv1.LocateStatus_LOCATE_STATUS_NOT_FOUND
+		return result, nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to locate IP address")
+	}
+	result.Status = geolocationv1.LocateStatus_LOCATE_STATUS_FOUND
+	result.Location = getLocation(geoLocation)
+
+	return result, nil
+}
+
+func getLocation(geoLocation repository.Geolocation) *geolocationv1.Location {
+	latitude, longitude := geoLocation.LatLon()
+	location := &geolocationv1.Location{
+		Country:     geoLocation.Country.String,
+		CountryCode: geoLocation.CountryCode.String,
+		City:        geoLocation.City.String,
+		Coordinates: &geolocationv1.Coordinates{Latitude: latitude, Longitude: longitude},
+	}
+	if country := geoLocation.CountryRef; country != nil {
+		location.Country = country.Name
+		location.CountryCode = country.Code
+		location.CountryAlpha3 = country.Alpha3
+		location.CountryNumeric = country.Numeric
+		location.Continent = country.Continent
+	}
+
+	return location
+}
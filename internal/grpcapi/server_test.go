@@ -0,0 +1,185 @@
+package grpcapi
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MaximChernomorov/challenge-test/internal/api"
+	"github.com/MaximChernomorov/challenge-test/internal/repository"
+	"github.com/MaximChernomorov/challenge-test/internal/repository/model"
+	geolocationv1 "github.com/MaximChernomorov/challenge-test/pkg/pb/geolocation/v1"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types/pgeo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newTestRepo(t *testing.T) repository.Repository {
+	repo := repository.NewMemoryRepo()
+	err := repo.AddGeolocationSlice(context.Background(), repository.GeolocationSlice{
+		GeolocationSlice: model.GeolocationSlice{{
+			IPAddress:     "10.0.0.0/8",
+			CountryCode:   null.StringFrom("NP"),
+			Country:       null.StringFrom("Nepal"),
+			City:          null.StringFrom("DuBuquemouth"),
+			Coordinates:   pgeo.NewPoint(7.206435933364332, -84.87503094689836),
+			CountryAlpha2: null.StringFrom("NP"),
+		}},
+	})
+	require.Nil(t, err)
+
+	return repo
+}
+
+func newTestClient(t *testing.T, repo repository.Repository) *grpc.ClientConn {
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	NewServer(repo, 3).Register(grpcServer)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn
+}
+
+func TestServer_Locate(t *testing.T) {
+	client := geolocationv1.NewGeolocationServiceClient(newTestClient(t, newTestRepo(t)))
+	tests := []struct {
+		name     string
+		ip       string
+		code     codes.Code
+		location *geolocationv1.Location
+	}{
+		{
+			name: "found",
+			ip:   "10.1.2.3",
+			code: codes.OK,
+			location: &geolocationv1.Location{
+				Country:        "Nepal",
+				CountryCode:    "NP",
+				City:           "DuBuquemouth",
+				Coordinates:    &geolocationv1.Coordinates{Latitude: -84.87503094689836, Longitude: 7.206435933364332},
+				CountryAlpha3:  "NPL",
+				CountryNumeric: "524",
+				Continent:      "AS",
+			},
+		},
+		{name: "not found", ip: "11.1.2.3", code: codes.NotFound},
+		{name: "invalid", ip: "11.1.2", code: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := client.Locate(context.Background(), &geolocationv1.LocateRequest{IpAddress: tt.ip})
+			require.Equal(t, tt.code, status.Code(err))
+			if tt.location != nil {
+				require.Equal(t, tt.location.String(), response.GetLocation().String())
+			}
+		})
+	}
+}
+
+func TestServer_LocateMany(t *testing.T) {
+	client := geolocationv1.NewGeolocationServiceClient(newTestClient(t, newTestRepo(t)))
+
+	response, err := client.LocateMany(context.Background(), &geolocationv1.LocateManyRequest{
+		IpAddresses: []string{"10.1.2.3", "11.1.2.3", "invalid"},
+	})
+	require.Nil(t, err)
+	statuses := make([]geolocationv1.LocateStatus, 0, len(response.GetResults()))
+	for _, result := range response.GetResults() {
+		statuses = append(statuses, result.GetStatus())
+	}
+	require.Equal(t, []geolocationv1.LocateStatus{
+		geolocationv1.LocateStatus_LOCATE_STATUS_FOUND,
+		geolocationv1.LocateStatus_LOCATE_STATUS_NOT_FOUND,
+		geolocationv1.LocateStatus_LOCATE_STATUS_INVALID,
+	}, statuses)
+	require.Equal(t, "DuBuquemouth", response.GetResults()[0].GetLocation().GetCity())
+	require.Nil(t, response.GetResults()[1].GetLocation())
+
+	_, err = client.LocateMany(context.Background(), &geolocationv1.LocateManyRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.LocateMany(context.Background(), &geolocationv1.LocateManyRequest{
+		IpAddresses: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_LocateStream(t *testing.T) {
+	client := geolocationv1.NewGeolocationServiceClient(newTestClient(t, newTestRepo(t)))
+	stream, err := client.LocateStream(context.Background())
+	require.Nil(t, err)
+
+	expected := map[string]geolocationv1.LocateStatus{
+		"10.1.2.3": geolocationv1.LocateStatus_LOCATE_STATUS_FOUND,
+		"11.1.2.3": geolocationv1.LocateStatus_LOCATE_STATUS_NOT_FOUND,
+		"invalid":  geolocationv1.LocateStatus_LOCATE_STATUS_INVALID,
+	}
+	for ip, expectedStatus := range expected {
+		require.Nil(t, stream.Send(&geolocationv1.LocateStreamRequest{IpAddress: ip}))
+		response, err := stream.Recv()
+		require.Nil(t, err)
+		require.Equal(t, ip, response.GetResult().GetIpAddress())
+		require.Equal(t, expectedStatus, response.GetResult().GetStatus())
+	}
+	require.Nil(t, stream.CloseSend())
+}
+
+func TestServer_Health(t *testing.T) {
+	client := healthpb.NewHealthClient(newTestClient(t, newTestRepo(t)))
+	response, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{
+		Service: geolocationv1.GeolocationService_ServiceDesc.ServiceName,
+	})
+	require.Nil(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, response.GetStatus())
+}
+
+func TestServer_LocateMatchesREST(t *testing.T) {
+	repo := newTestRepo(t)
+	client := geolocationv1.NewGeolocationServiceClient(newTestClient(t, repo))
+	response, err := client.Locate(context.Background(), &geolocationv1.LocateRequest{IpAddress: "10.1.2.3"})
+	require.Nil(t, err)
+
+	restAPI := api.API{}
+	restAPI.SetRepo(repo)
+	recorder := httptest.NewRecorder()
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/api/ip/10.1.2.3", nil), recorder)
+	c.SetParamNames("ip")
+	c.SetParamValues("10.1.2.3")
+	require.Nil(t, restAPI.LocateIPByPath(c))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	restResponse := struct {
+		Coordinates struct {
+			Latitude  float64 `json:"latitude"`
+			Longitude float64 `json:"longitude"`
+		} `json:"coordinates"`
+	}{}
+	require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &restResponse))
+
+	require.Equal(t, -84.87503094689836, response.GetLocation().GetCoordinates().GetLatitude())
+	require.Equal(t, response.GetLocation().GetCoordinates().GetLatitude(), restResponse.Coordinates.Latitude)
+	require.Equal(t, response.GetLocation().GetCoordinates().GetLongitude(), restResponse.Coordinates.Longitude)
+}
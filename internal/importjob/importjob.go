@@ -0,0 +1,266 @@
+package importjob
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+)
+
+// Status is import job state, jobs are run one by one in order of submission
+type Status string
+
+const (
+	StatusQueued    Status = "queued"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+var (
+	// ErrQueueFull is returned when job is submitted while queue is full
+	ErrQueueFull = errors.New("import queue is full")
+	// ErrJobNotFound is returned for unknown job or job which was forgotten
+	ErrJobNotFound = errors.New("import job not found")
+)
+
+// Options are import options, see import command flags
+type Options struct {
+	Format        string `json:"format"`
+	Mode          string `json:"mode"`
+	Duplicates    string `json:"duplicates"`
+	DeleteMissing bool   `json:"delete_missing"`
+	Promote       bool   `json:"promote"`
+}
+
+// Source is file imported by job
+type Source struct {
+	// Path is path of file on server
+	Path string
+	// Name is name of source reported in job, e.g. name of uploaded file
+	Name string
+	// Temporary file is removed once job is finished
+	Temporary bool
+}
+
+// Progress is count of rows read and networks passed to repository so far
+type Progress struct {
+	Accepted  int `json:"accepted"`
+	Discarded int `json:"discarded"`
+	Networks  int `json:"networks"`
+}
+
+// Result describes finished import
+type Result struct {
+	DiscardedByReason map[string]int `json:"discarded_by_reason,omitempty"`
+	// ImportID is id of import run in import history
+	ImportID  int `json:"import_id"`
+	DatasetID int `json:"dataset_id"`
+	Inserted  int `json:"inserted"`
+	Updated   int `json:"updated"`
+	Unchanged int `json:"unchanged"`
+	Deleted   int `json:"deleted"`
+	Pruned    int `json:"pruned"`
+}
+
+// Runner imports job sources
+type Runner interface {
+	// Check validates options before job is queued
+	Check(options Options) error
+	// Run imports source and reports progress while import is running, nothing should be stored if ctx is cancelled
+	Run(ctx context.Context, source Source, options Options, progress func(Progress)) (Result, error)
+}
+
+// Job is import job snapshot
+type Job struct {
+	ID         int        `json:"id"`
+	Status     Status     `json:"status"`
+	Source     string     `json:"source"`
+	Options    Options    `json:"options"`
+	Progress   Progress   `json:"progress"`
+	Result     *Result    `json:"result,omitempty"`
+	Error      string     `json:"error,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// ElapsedSeconds is run time of job so far
+	ElapsedSeconds float64 `json:"elapsed_seconds"`
+}
+
+// job is import job with its source, it is guarded by Manager mutex
+type job struct {
+	Job
+	source Source
+}
+
+// Manager runs import jobs one by one in background and keeps their state in memory
+type Manager struct {
+	runner Runner
+	// keep is count of finished jobs kept for status requests
+	keep  int
+	queue chan *job
+
+	mu     sync.Mutex
+	jobs   map[int]*job
+	lastID int
+	// finished are ids of finished jobs, the oldest first
+	finished []int
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewManager starts worker running jobs, at most queueSize jobs wait for it
+func NewManager(runner Runner, queueSize, keep int) *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	manager := &Manager{
+		runner: runner,
+		keep:   keep,
+		queue:  make(chan *job, queueSize),
+		jobs:   make(map[int]*job),
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go manager.work()
+
+	return manager
+}
+
+// Check validates import options
+func (manager *Manager) Check(options Options) error {
+	return manager.runner.Check(options)
+}
+
+// Submit queues import of source, temporary source is removed if it isn't queued
+func (manager *Manager) Submit(source Source, options Options) (Job, error) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	if manager.ctx.Err() != nil {
+		removeTemporary(source)
+		return Job{}, errors.New("import jobs are stopped")
+	}
+	newJob := &job{
+		Job: Job{
+			ID:        manager.lastID + 1,
+			Status:    StatusQueued,
+			Source:    source.Name,
+			Options:   options,
+			CreatedAt: time.Now(),
+		},
+		source: source,
+	}
+	select {
+	case manager.queue <- newJob:
+	default:
+		removeTemporary(source)
+		return Job{}, ErrQueueFull
+	}
+	manager.lastID = newJob.ID
+	manager.jobs[newJob.ID] = newJob
+
+	return newJob.snapshot(), nil
+}
+
+// Get returns job snapshot
+func (manager *Manager) Get(id int) (Job, error) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	found, exists := manager.jobs[id]
+	if !exists {
+		return Job{}, errors.Wrapf(ErrJobNotFound, "job %d", id)
+	}
+
+	return found.snapshot(), nil
+}
+
+// Close cancels running job, fails queued ones and waits for worker to stop
+func (manager *Manager) Close() {
+	manager.mu.Lock()
+	manager.cancel()
+	manager.mu.Unlock()
+	<-manager.done
+}
+
+func (manager *Manager) work() {
+	defer close(manager.done)
+	for {
+		select {
+		case next := <-manager.queue:
+			manager.run(next)
+		case <-manager.ctx.Done():
+			for {
+				select {
+				case next := <-manager.queue:
+					manager.finish(next, Result{}, errors.New("import jobs are stopped"))
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (manager *Manager) run(next *job) {
+	if manager.ctx.Err() != nil {
+		manager.finish(next, Result{}, errors.New("import jobs are stopped"))
+		return
+	}
+	manager.mu.Lock()
+	startedAt := time.Now()
+	next.Status = StatusRunning
+	next.StartedAt = &startedAt
+	manager.mu.Unlock()
+
+	result, err := manager.runner.Run(manager.ctx, next.source, next.Options, func(progress Progress) {
+		manager.mu.Lock()
+		next.Progress = progress
+		manager.mu.Unlock()
+	})
+	manager.finish(next, result, err)
+}
+
+// finish records job result, removes its temporary source and forgets the oldest finished jobs beyond keep
+func (manager *Manager) finish(finished *job, result Result, err error) {
+	removeTemporary(finished.source)
+
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	finishedAt := time.Now()
+	finished.FinishedAt = &finishedAt
+	finished.Status = StatusSucceeded
+	finished.Result = &result
+	if err != nil {
+		finished.Status = StatusFailed
+		finished.Result = nil
+		finished.Error = err.Error()
+	}
+	manager.finished = append(manager.finished, finished.ID)
+	for len(manager.finished) > manager.keep {
+		delete(manager.jobs, manager.finished[0])
+		manager.finished = manager.finished[1:]
+	}
+}
+
+// snapshot should be called under Manager lock
+func (job *job) snapshot() Job {
+	snapshot := job.Job
+	if job.StartedAt != nil {
+		finishedAt := time.Now()
+		if job.FinishedAt != nil {
+			finishedAt = *job.FinishedAt
+		}
+		snapshot.ElapsedSeconds = finishedAt.Sub(*job.StartedAt).Seconds()
+	}
+
+	return snapshot
+}
+
+func removeTemporary(source Source) {
+	if source.Temporary {
+		_ = os.Remove(source.Path)
+	}
+}
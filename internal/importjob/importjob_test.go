@@ -0,0 +1,113 @@
+package importjob
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingRunner runs jobs once they are released, jobs of sources named "fail" fail
+type blockingRunner struct {
+	release chan struct{}
+}
+
+func (runner blockingRunner) Check(options Options) error {
+	if options.Mode != "append" {
+		return errors.Errorf("unknown import mode %q", options.Mode)
+	}
+	return nil
+}
+
+func (runner blockingRunner) Run(
+	ctx context.Context,
+	source Source,
+	options Options,
+	progress func(Progress),
+) (Result, error) {
+	progress(Progress{Accepted: 1})
+	select {
+	case <-runner.release:
+	case <-ctx.Done():
+		return Result{}, ctx.Err()
+	}
+	if source.Name == "fail" {
+		return Result{}, errors.New("import failed")
+	}
+	progress(Progress{Accepted: 2, Networks: 2})
+
+	return Result{DatasetID: 1, Inserted: 2}, nil
+}
+
+func requireStatus(t *testing.T, manager *Manager, id int, status Status) Job {
+	var job Job
+	require.Eventually(t, func() bool {
+		var err error
+		job, err = manager.Get(id)
+		require.Nil(t, err)
+		return job.Status == status
+	}, time.Second, time.Millisecond)
+
+	return job
+}
+
+func TestManager(t *testing.T) {
+	runner := blockingRunner{release: make(chan struct{})}
+	manager := NewManager(runner, 1, 1)
+	defer manager.Close()
+	options := Options{Mode: "append"}
+	require.Nil(t, manager.Check(options))
+	require.NotNil(t, manager.Check(Options{Mode: "unknown"}))
+
+	temporary := filepath.Join(t.TempDir(), "upload.csv")
+	require.Nil(t, os.WriteFile(temporary, []byte("ip_address\n"), 0o600))
+	first, err := manager.Submit(Source{Path: temporary, Name: "upload.csv", Temporary: true}, options)
+	require.Nil(t, err)
+	require.Equal(t, StatusQueued, first.Status)
+	job := requireStatus(t, manager, first.ID, StatusRunning)
+	require.NotNil(t, job.StartedAt)
+
+	second, err := manager.Submit(Source{Name: "fail"}, options)
+	require.Nil(t, err)
+	_, err = manager.Submit(Source{Name: "third"}, options)
+	require.ErrorIs(t, err, ErrQueueFull)
+
+	runner.release <- struct{}{}
+	job = requireStatus(t, manager, first.ID, StatusSucceeded)
+	require.Equal(t, Progress{Accepted: 2, Networks: 2}, job.Progress)
+	require.Equal(t, &Result{DatasetID: 1, Inserted: 2}, job.Result)
+	require.NotNil(t, job.FinishedAt)
+	_, err = os.Stat(temporary)
+	require.True(t, os.IsNotExist(err), "temporary source is removed")
+
+	runner.release <- struct{}{}
+	job = requireStatus(t, manager, second.ID, StatusFailed)
+	require.Equal(t, "import failed", job.Error)
+	require.Nil(t, job.Result)
+	// only the last finished job is kept
+	_, err = manager.Get(first.ID)
+	require.ErrorIs(t, err, ErrJobNotFound)
+}
+
+func TestManager_Close(t *testing.T) {
+	manager := NewManager(blockingRunner{release: make(chan struct{})}, 1, 10)
+	running, err := manager.Submit(Source{Name: "running"}, Options{Mode: "append"})
+	require.Nil(t, err)
+	requireStatus(t, manager, running.ID, StatusRunning)
+	queued, err := manager.Submit(Source{Name: "queued"}, Options{Mode: "append"})
+	require.Nil(t, err)
+
+	manager.Close()
+	job, err := manager.Get(running.ID)
+	require.Nil(t, err)
+	require.Equal(t, StatusFailed, job.Status)
+	job, err = manager.Get(queued.ID)
+	require.Nil(t, err)
+	require.Equal(t, StatusFailed, job.Status)
+	_, err = manager.Submit(Source{Name: "late"}, Options{Mode: "append"})
+	require.NotNil(t, err)
+}
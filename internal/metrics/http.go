@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// HTTPMetrics counts api requests and their latency per route and response status
+type HTTPMetrics struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+func NewHTTPMetrics(registerer prometheus.Registerer) *HTTPMetrics {
+	labels := []string{"method", "route", "status"}
+	metrics := &HTTPMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "Count of handled http requests.",
+		}, labels),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of handled http requests.",
+			Buckets:   prometheus.DefBuckets,
+		}, labels),
+	}
+	registerer.MustRegister(metrics.requests, metrics.duration)
+
+	return metrics
+}
+
+// Middleware observes every request, route is labeled by its pattern, so path parameters don't blow up cardinality
+func (metrics *HTTPMetrics) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+			status := c.Response().Status
+			if err != nil {
+				// error is written into response by echo error handler later
+				status = http.StatusInternalServerError
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					status = httpErr.Code
+				}
+			}
+			labels := prometheus.Labels{
+				"method": c.Request().Method,
+				"route":  c.Path(),
+				"status": strconv.Itoa(status),
+			}
+			metrics.requests.With(labels).Inc()
+			metrics.duration.With(labels).Observe(time.Since(start).Seconds())
+
+			return err
+		}
+	}
+}
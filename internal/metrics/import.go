@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+)
+
+// importJob is pushgateway job name of import command
+const importJob = "geolocation_import"
+
+// ImportRun describes finished import command run
+type ImportRun struct {
+	Accepted          int
+	DiscardedByReason map[string]int
+	Networks          int
+	Duration          time.Duration
+	Failed            bool
+}
+
+// Registry returns registry with run metrics, import is batch job, so all of them are gauges describing the last run
+func (run ImportRun) Registry() *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+	gauge := func(name, help string, value float64) {
+		collector := prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "import",
+			Name:      name,
+			Help:      help,
+		})
+		collector.Set(value)
+		registry.MustRegister(collector)
+	}
+	gauge("rows_accepted", "Count of rows accepted by the last import.", float64(run.Accepted))
+	gauge("networks_stored", "Count of networks stored by the last import.", float64(run.Networks))
+	gauge("duration_seconds", "Duration of the last import.", run.Duration.Seconds())
+	gauge("last_run_timestamp_seconds", "Finish time of the last import.", float64(time.Now().Unix()))
+	succeeded := 1.0
+	if run.Failed {
+		succeeded = 0
+	}
+	gauge("succeeded", "Whether the last import succeeded.", succeeded)
+
+	discarded := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "import",
+		Name:      "rows_discarded",
+		Help:      "Count of rows discarded by the last import by reason.",
+	}, []string{"reason"})
+	for reason, count := range run.DiscardedByReason {
+		discarded.WithLabelValues(reason).Set(float64(count))
+	}
+	registry.MustRegister(discarded)
+
+	return registry
+}
+
+// Push sends run metrics to pushgateway, metrics of the previous run are replaced
+func (run ImportRun) Push(url string) error {
+	err := push.New(url, importJob).Gatherer(run.Registry()).Push()
+
+	return errors.Wrap(err, "failed to push import metrics")
+}
+
+// WriteTextfile writes run metrics for node exporter textfile collector
+func (run ImportRun) WriteTextfile(path string) error {
+	err := prometheus.WriteToTextfile(path, run.Registry())
+
+	return errors.Wrap(err, "failed to write import metrics")
+}
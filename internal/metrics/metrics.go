@@ -0,0 +1,4 @@
+// Package metrics exposes prometheus metrics of api and import command
+package metrics
+
+const namespace = "geolocation"
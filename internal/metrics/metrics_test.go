@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository"
+	"github.com/MaximChernomorov/challenge-test/internal/repository/model"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPMetrics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	metrics := NewHTTPMetrics(registry)
+	e := echo.New()
+	e.Use(metrics.Middleware())
+	e.GET("/ip/:ip", func(c echo.Context) error {
+		if c.Param("ip") == "fail" {
+			return echo.NewHTTPError(http.StatusBadRequest)
+		}
+		return c.NoContent(http.StatusOK)
+	})
+
+	for _, path := range []string{"/ip/1.1.1.1", "/ip/2.2.2.2", "/ip/fail"} {
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	require.Equal(t, 2.0, testutil.ToFloat64(metrics.requests.WithLabelValues(http.MethodGet, "/ip/:ip", "200")))
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.requests.WithLabelValues(http.MethodGet, "/ip/:ip", "400")))
+	require.Equal(t, 2, testutil.CollectAndCount(metrics.duration))
+}
+
+func TestInstrumentedRepo(t *testing.T) {
+	memoryRepo := repository.NewMemoryRepo()
+	err := memoryRepo.AddGeolocationSlice(context.Background(), repository.GeolocationSlice{
+		GeolocationSlice: model.GeolocationSlice{{IPAddress: "1.1.1.1"}},
+	})
+	require.Nil(t, err)
+	repo := NewInstrumentedRepo(memoryRepo, prometheus.NewRegistry())
+
+	_, err = repo.LocateIP(context.Background(), "1.1.1.1")
+	require.Nil(t, err)
+	_, err = repo.LocateIP(context.Background(), "2.2.2.2")
+	require.ErrorIs(t, err, repository.ErrLocationNotFound)
+	_, err = repo.LocateIPs(context.Background(), []string{"1.1.1.1", "2.2.2.2", "3.3.3.3"})
+	require.Nil(t, err)
+
+	require.Equal(t, 2.0, testutil.ToFloat64(repo.lookups.WithLabelValues(lookupFound)))
+	require.Equal(t, 3.0, testutil.ToFloat64(repo.lookups.WithLabelValues(lookupNotFound)))
+}
+
+func TestImportRun_Registry(t *testing.T) {
+	registry := ImportRun{
+		Accepted:          10,
+		DiscardedByReason: map[string]int{"invalid_ip": 2, "duplicate_ip": 1},
+		Networks:          12,
+		Duration:          time.Second,
+	}.Registry()
+
+	families, err := registry.Gather()
+	require.Nil(t, err)
+	values := make(map[string][]float64)
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			values[family.GetName()] = append(values[family.GetName()], metric.GetGauge().GetValue())
+		}
+	}
+	require.Equal(t, []float64{10}, values["geolocation_import_rows_accepted"])
+	require.Equal(t, []float64{12}, values["geolocation_import_networks_stored"])
+	require.Equal(t, []float64{1}, values["geolocation_import_duration_seconds"])
+	require.Equal(t, []float64{1}, values["geolocation_import_succeeded"])
+	require.ElementsMatch(t, []float64{1, 2}, values["geolocation_import_rows_discarded"])
+}
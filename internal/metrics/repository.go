@@ -0,0 +1,104 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository"
+	"github.com/friendsofgo/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	lookupFound    = "found"
+	lookupNotFound = "not_found"
+	lookupError    = "error"
+)
+
+// InstrumentedRepo decorates repository.Repository with count of IP lookups by result
+type InstrumentedRepo struct {
+	repository.Repository
+	lookups *prometheus.CounterVec
+}
+
+func NewInstrumentedRepo(repo repository.Repository, registerer prometheus.Registerer) *InstrumentedRepo {
+	instrumentedRepo := &InstrumentedRepo{
+		Repository: repo,
+		lookups: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "lookups_total",
+			Help:      "Count of IP address lookups by result: found, not_found or error.",
+		}, []string{"result"}),
+	}
+	registerer.MustRegister(instrumentedRepo.lookups)
+
+	return instrumentedRepo
+}
+
+func (repo *InstrumentedRepo) LocateIP(ctx context.Context, IP string) (repository.Geolocation, error) {
+	location, err := repo.Repository.LocateIP(ctx, IP)
+	switch {
+	case err == nil:
+		repo.lookups.WithLabelValues(lookupFound).Inc()
+	case errors.Is(err, repository.ErrLocationNotFound):
+		repo.lookups.WithLabelValues(lookupNotFound).Inc()
+	default:
+		repo.lookups.WithLabelValues(lookupError).Inc()
+	}
+
+	return location, err
+}
+
+func (repo *InstrumentedRepo) LocateIPs(ctx context.Context, IPs []string) (map[string]repository.Geolocation, error) {
+	locations, err := repo.Repository.LocateIPs(ctx, IPs)
+	if err != nil {
+		repo.lookups.WithLabelValues(lookupError).Add(float64(len(IPs)))
+		return locations, err
+	}
+	repo.lookups.WithLabelValues(lookupFound).Add(float64(len(locations)))
+	repo.lookups.WithLabelValues(lookupNotFound).Add(float64(len(IPs) - len(locations)))
+
+	return locations, nil
+}
+
+// cacheCollector exports repository.CachedRepo stats
+type cacheCollector struct {
+	cache  *repository.CachedRepo
+	hits   *prometheus.Desc
+	misses *prometheus.Desc
+	size   *prometheus.Desc
+}
+
+// RegisterCache exports lookup cache hits, misses and size
+func RegisterCache(registerer prometheus.Registerer, cache *repository.CachedRepo) {
+	registerer.MustRegister(&cacheCollector{
+		cache: cache,
+		hits: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "cache", "hits_total"),
+			"Count of IP address lookups served from cache.",
+			nil, nil,
+		),
+		misses: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "cache", "misses_total"),
+			"Count of IP address lookups passed to repository.",
+			nil, nil,
+		),
+		size: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "cache", "size"),
+			"Count of cached IP addresses.",
+			nil, nil,
+		),
+	})
+}
+
+func (collector *cacheCollector) Describe(descs chan<- *prometheus.Desc) {
+	descs <- collector.hits
+	descs <- collector.misses
+	descs <- collector.size
+}
+
+func (collector *cacheCollector) Collect(metrics chan<- prometheus.Metric) {
+	stats := collector.cache.CacheStats()
+	metrics <- prometheus.MustNewConstMetric(collector.hits, prometheus.CounterValue, float64(stats.Hits))
+	metrics <- prometheus.MustNewConstMetric(collector.misses, prometheus.CounterValue, float64(stats.Misses))
+	metrics <- prometheus.MustNewConstMetric(collector.size, prometheus.GaugeValue, float64(stats.Size))
+}
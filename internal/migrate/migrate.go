@@ -0,0 +1,77 @@
+// Package migrate applies embedded SQL migrations to postgres db with goose
+package migrate
+
+import (
+	"database/sql"
+
+	"github.com/MaximChernomorov/challenge-test/migrations"
+	"github.com/friendsofgo/errors"
+	"github.com/pressly/goose/v3"
+)
+
+// migrationsDir is directory of migrations inside embedded FS
+const migrationsDir = "."
+
+var ErrSchemaBehind = errors.New("db schema is behind migrations")
+
+func init() {
+	goose.SetBaseFS(migrations.FS)
+	// postgres dialect is always known to goose
+	_ = goose.SetDialect("postgres")
+}
+
+// Up applies all pending migrations
+func Up(db *sql.DB) error {
+	return errors.Wrap(goose.Up(db, migrationsDir), "failed to apply migrations")
+}
+
+// Down rolls back the latest applied migration
+func Down(db *sql.DB) error {
+	return errors.Wrap(goose.Down(db, migrationsDir), "failed to roll back migration")
+}
+
+// Status logs every migration with time it was applied at
+func Status(db *sql.DB) error {
+	return errors.Wrap(goose.Status(db, migrationsDir), "failed to get migrations status")
+}
+
+// Version returns version of db schema and the latest embedded migration version
+func Version(db *sql.DB) (current int64, latest int64, err error) {
+	latest, err = LatestVersion()
+	if err != nil {
+		return 0, 0, err
+	}
+	current, err = goose.GetDBVersion(db)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "failed to get db schema version")
+	}
+
+	return current, latest, nil
+}
+
+// LatestVersion returns version of the latest embedded migration
+func LatestVersion() (int64, error) {
+	collected, err := goose.CollectMigrations(migrationsDir, 0, goose.MaxVersion)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to collect migrations")
+	}
+	last, err := collected.Last()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to collect migrations")
+	}
+
+	return last.Version, nil
+}
+
+// Check returns ErrSchemaBehind if db schema misses some of embedded migrations
+func Check(db *sql.DB) error {
+	current, latest, err := Version(db)
+	if err != nil {
+		return err
+	}
+	if current < latest {
+		return errors.Wrapf(ErrSchemaBehind, "schema version %d, latest migration %d", current, latest)
+	}
+
+	return nil
+}
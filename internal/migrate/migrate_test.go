@@ -0,0 +1,54 @@
+package migrate
+
+import (
+	"io/fs"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/MaximChernomorov/challenge-test/migrations"
+	"github.com/MaximChernomorov/challenge-test/pkg/importer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatestVersion(t *testing.T) {
+	files, err := fs.Glob(migrations.FS, "*.sql")
+	require.Nil(t, err)
+	require.NotEmpty(t, files)
+	var expected int64
+	for _, file := range files {
+		version, err := strconv.ParseInt(strings.SplitN(file, "_", 2)[0], 10, 64)
+		require.Nil(t, err)
+		if version > expected {
+			expected = version
+		}
+	}
+
+	latest, err := LatestVersion()
+	require.Nil(t, err)
+	require.Equal(t, expected, latest)
+}
+
+func TestCountriesSeed(t *testing.T) {
+	migration, err := fs.ReadFile(migrations.FS, "20261018093000_countries.sql")
+	require.Nil(t, err)
+	seedRegexp := regexp.MustCompile(`\('([A-Z]{2})', '([A-Z]{3})', '([0-9]{3})', '((?:[^']|'')*)', '([A-Z]{2})'\)`)
+	var seeded []importer.Country
+	for _, match := range seedRegexp.FindAllStringSubmatch(string(migration), -1) {
+		seeded = append(seeded, importer.Country{
+			Code:      match[1],
+			Alpha3:    match[2],
+			Numeric:   match[3],
+			Name:      strings.ReplaceAll(match[4], "''", "'"),
+			Continent: match[5],
+		})
+	}
+
+	countries := importer.Countries()
+	require.Len(t, seeded, len(countries))
+	for i, country := range countries {
+		country.Aliases = nil
+		require.Equal(t, country, seeded[i])
+	}
+}
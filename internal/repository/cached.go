@@ -0,0 +1,324 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/friendsofgo/errors"
+)
+
+// CacheOptions configures CachedRepo
+type CacheOptions struct {
+	// Size is max count of cached IP addresses
+	Size int
+	// TTL is cache time of found locations
+	TTL time.Duration
+	// NegativeTTL is cache time of IP addresses which weren't found
+	NegativeTTL time.Duration
+}
+
+// CacheStats describes CachedRepo usage
+type CacheStats struct {
+	Hits   int64 `json:"hits"`
+	Misses int64 `json:"misses"`
+	Size   int   `json:"size"`
+}
+
+const (
+	// maxCachedStats is max count of cached aggregate results, city stats are cached by country code
+	maxCachedStats = 100
+	// MaxCityStatsLimit is count of cities cached by country code, api doesn't request more of them
+	MaxCityStatsLimit = 1000
+)
+
+// CachedRepo decorates Repository with LRU cache of IP lookups and cache of aggregate stats. Cache is invalidated when
+// active dataset is changed through it, changes made by other processes should be reported with Invalidate
+type CachedRepo struct {
+	Repository
+	options CacheOptions
+	cache   *lruCache
+	// generation is increased by Invalidate, so lookups started before invalidation don't cache outdated results
+	generation atomic.Int64
+	hits       atomic.Int64
+	misses     atomic.Int64
+
+	statsMu sync.Mutex
+	// stats are cached aggregate results by request, they are recomputed one by one in background on invalidation
+	stats map[string]*cachedStats
+}
+
+// cachedStats is aggregate result with function computing it, value is nil until it is computed
+type cachedStats struct {
+	compute func(ctx context.Context) (interface{}, error)
+	value   interface{}
+}
+
+// cachedLocation is cached lookup result, found is false for IP addresses which weren't found
+type cachedLocation struct {
+	location Geolocation
+	found    bool
+}
+
+func NewCachedRepo(repo Repository, options CacheOptions) *CachedRepo {
+	return &CachedRepo{
+		Repository: repo,
+		options:    options,
+		cache:      newLRUCache(options.Size),
+		stats:      make(map[string]*cachedStats),
+	}
+}
+
+func (repo *CachedRepo) LocateIP(ctx context.Context, IP string) (Geolocation, error) {
+	if cached, exists := repo.get(IP); exists {
+		if !cached.found {
+			return Geolocation{}, ErrLocationNotFound
+		}
+		return cached.location, nil
+	}
+
+	generation := repo.generation.Load()
+	location, err := repo.Repository.LocateIP(ctx, IP)
+	if errors.Is(err, ErrLocationNotFound) {
+		repo.set(generation, IP, cachedLocation{})
+	} else if err == nil {
+		repo.set(generation, IP, cachedLocation{location: location, found: true})
+	}
+
+	return location, err
+}
+
+// LocateIPs takes cached IP addresses from cache and locates the rest with single call of decorated repository
+func (repo *CachedRepo) LocateIPs(ctx context.Context, IPs []string) (map[string]Geolocation, error) {
+	locations := make(map[string]Geolocation, len(IPs))
+	missed := make([]string, 0)
+	for _, IP := range IPs {
+		cached, exists := repo.get(IP)
+		if !exists {
+			missed = append(missed, IP)
+			continue
+		}
+		if cached.found {
+			locations[IP] = cached.location
+		}
+	}
+	if len(missed) == 0 {
+		return locations, nil
+	}
+
+	generation := repo.generation.Load()
+	located, err := repo.Repository.LocateIPs(ctx, missed)
+	if err != nil {
+		return nil, err
+	}
+	for _, IP := range missed {
+		location, found := located[IP]
+		repo.set(generation, IP, cachedLocation{location: location, found: found})
+		if found {
+			locations[IP] = location
+		}
+	}
+
+	return locations, nil
+}
+
+func (repo *CachedRepo) Stats(ctx context.Context) (Stats, error) {
+	value, err := repo.cachedStats(ctx, "stats", func(ctx context.Context) (interface{}, error) {
+		return repo.Repository.Stats(ctx)
+	})
+	if err != nil {
+		return Stats{}, err
+	}
+
+	return value.(Stats), nil
+}
+
+func (repo *CachedRepo) CountryStats(ctx context.Context) ([]CountryStats, error) {
+	value, err := repo.cachedStats(ctx, "countries", func(ctx context.Context) (interface{}, error) {
+		return repo.Repository.CountryStats(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return value.([]CountryStats), nil
+}
+
+// CityStats caches MaxCityStatsLimit cities of country once and returns the first limit of them
+func (repo *CachedRepo) CityStats(ctx context.Context, countryCode string, limit int) ([]CityStats, error) {
+	if limit < 1 || limit > MaxCityStatsLimit {
+		return repo.Repository.CityStats(ctx, countryCode, limit)
+	}
+	value, err := repo.cachedStats(ctx, "cities:"+countryCode, func(ctx context.Context) (interface{}, error) {
+		return repo.Repository.CityStats(ctx, countryCode, MaxCityStatsLimit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	cities := value.([]CityStats)
+	if len(cities) > limit {
+		// capacity is limited too, so appending to result doesn't change cached cities
+		cities = cities[:limit:limit]
+	}
+
+	return cities, nil
+}
+
+func (repo *CachedRepo) AddGeolocationSlice(ctx context.Context, geolocationSlice GeolocationSlice) error {
+	err := repo.Repository.AddGeolocationSlice(ctx, geolocationSlice)
+	if err == nil {
+		repo.Invalidate()
+	}
+
+	return err
+}
+
+func (repo *CachedRepo) ImportGeolocationBatches(
+	ctx context.Context,
+	batches <-chan GeolocationSlice,
+	options ImportOptions,
+) (ImportResult, error) {
+	result, err := repo.Repository.ImportGeolocationBatches(ctx, batches, options)
+	if err == nil && options.Promote {
+		repo.Invalidate()
+	}
+
+	return result, err
+}
+
+func (repo *CachedRepo) PromoteDataset(ctx context.Context, id int) error {
+	err := repo.Repository.PromoteDataset(ctx, id)
+	if err == nil {
+		repo.Invalidate()
+	}
+
+	return err
+}
+
+func (repo *CachedRepo) RollbackDataset(ctx context.Context) (int, error) {
+	id, err := repo.Repository.RollbackDataset(ctx)
+	if err == nil {
+		repo.Invalidate()
+	}
+
+	return id, err
+}
+
+// Invalidate drops all cached lookups and starts recomputing cached stats one by one in background, stats are
+// computed on request until then
+func (repo *CachedRepo) Invalidate() {
+	generation := repo.generation.Add(1)
+	repo.cache.Purge()
+
+	repo.statsMu.Lock()
+	defer repo.statsMu.Unlock()
+	outdated := make(map[string]func(ctx context.Context) (interface{}, error), len(repo.stats))
+	for key, stats := range repo.stats {
+		stats.value = nil
+		outdated[key] = stats.compute
+	}
+	if len(outdated) != 0 {
+		go repo.refreshStats(generation, outdated)
+	}
+}
+
+// CacheStats returns cache hits and misses since start and current cache size
+func (repo *CachedRepo) CacheStats() CacheStats {
+	return CacheStats{
+		Hits:   repo.hits.Load(),
+		Misses: repo.misses.Load(),
+		Size:   repo.cache.Len(),
+	}
+}
+
+// cachedStats returns cached aggregate result or computes and caches it
+func (repo *CachedRepo) cachedStats(
+	ctx context.Context,
+	key string,
+	compute func(ctx context.Context) (interface{}, error),
+) (interface{}, error) {
+	var value interface{}
+	repo.statsMu.Lock()
+	if stats, exists := repo.stats[key]; exists {
+		value = stats.value
+	}
+	repo.statsMu.Unlock()
+	if value != nil {
+		return value, nil
+	}
+
+	generation := repo.generation.Load()
+	value, err := compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+	repo.setStats(generation, key, compute, value)
+
+	return value, nil
+}
+
+// refreshStats recomputes aggregate results after invalidation of generation sequentially, so invalidation doesn't
+// run all aggregate queries at once. Result is computed on the next request if this fails, refresh stops once cache
+// is invalidated again
+func (repo *CachedRepo) refreshStats(
+	generation int64,
+	outdated map[string]func(ctx context.Context) (interface{}, error),
+) {
+	for key, compute := range outdated {
+		if repo.generation.Load() != generation {
+			return
+		}
+		repo.statsMu.Lock()
+		stats, exists := repo.stats[key]
+		computed := exists && stats.value != nil
+		repo.statsMu.Unlock()
+		if computed {
+			// it is already computed on request
+			continue
+		}
+		value, err := compute(context.Background())
+		if err == nil {
+			repo.setStats(generation, key, compute, value)
+		}
+	}
+}
+
+func (repo *CachedRepo) setStats(
+	generation int64,
+	key string,
+	compute func(ctx context.Context) (interface{}, error),
+	value interface{},
+) {
+	repo.statsMu.Lock()
+	defer repo.statsMu.Unlock()
+	if repo.generation.Load() != generation {
+		return
+	}
+	if _, exists := repo.stats[key]; !exists && len(repo.stats) >= maxCachedStats {
+		return
+	}
+	repo.stats[key] = &cachedStats{compute: compute, value: value}
+}
+
+func (repo *CachedRepo) get(IP string) (cachedLocation, bool) {
+	value, exists := repo.cache.Get(IP)
+	if !exists {
+		repo.misses.Add(1)
+		return cachedLocation{}, false
+	}
+	repo.hits.Add(1)
+
+	return value.(cachedLocation), true
+}
+
+func (repo *CachedRepo) set(generation int64, IP string, location cachedLocation) {
+	if repo.generation.Load() != generation {
+		return
+	}
+	ttl := repo.options.TTL
+	if !location.found {
+		ttl = repo.options.NegativeTTL
+	}
+	repo.cache.Set(IP, location, ttl)
+}
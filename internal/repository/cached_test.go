@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// countingRepo counts lookups and stats calls reaching decorated repository, stats are counted atomically as they
+// are recomputed in background
+type countingRepo struct {
+	Repository
+	lookups int
+	stats   atomic.Int64
+	cities  atomic.Int64
+	// running is count of city stats computed at the moment, maxRunning is the highest one
+	running    atomic.Int64
+	maxRunning atomic.Int64
+}
+
+func (repo *countingRepo) Stats(ctx context.Context) (Stats, error) {
+	repo.stats.Add(1)
+	return repo.Repository.Stats(ctx)
+}
+
+func (repo *countingRepo) CityStats(ctx context.Context, countryCode string, limit int) ([]CityStats, error) {
+	repo.cities.Add(1)
+	running := repo.running.Add(1)
+	defer repo.running.Add(-1)
+	for maxRunning := repo.maxRunning.Load(); running > maxRunning; maxRunning = repo.maxRunning.Load() {
+		repo.maxRunning.CompareAndSwap(maxRunning, running)
+	}
+	time.Sleep(time.Millisecond)
+
+	return repo.Repository.CityStats(ctx, countryCode, limit)
+}
+
+func (repo *countingRepo) LocateIP(ctx context.Context, IP string) (Geolocation, error) {
+	repo.lookups++
+	return repo.Repository.LocateIP(ctx, IP)
+}
+
+func (repo *countingRepo) LocateIPs(ctx context.Context, IPs []string) (map[string]Geolocation, error) {
+	repo.lookups += len(IPs)
+	return repo.Repository.LocateIPs(ctx, IPs)
+}
+
+func newTestCachedRepo(t *testing.T, options CacheOptions) (*CachedRepo, *countingRepo, *time.Time) {
+	memoryRepo := NewMemoryRepo()
+	err := memoryRepo.AddGeolocationSlice(context.Background(), geolocationSlice(
+		geolocation("1.1.1.1", "One"),
+		geolocation("2.2.2.2", "Two"),
+	))
+	require.Nil(t, err)
+	counting := &countingRepo{Repository: memoryRepo}
+	repo := NewCachedRepo(counting, options)
+	now := time.Now()
+	repo.cache.now = func() time.Time {
+		return now
+	}
+
+	return repo, counting, &now
+}
+
+func TestCachedRepo_TTL(t *testing.T) {
+	repo, counting, now := newTestCachedRepo(t, CacheOptions{Size: 10, TTL: time.Minute, NegativeTTL: time.Second})
+
+	requireCity(t, repo, "1.1.1.1", "One")
+	requireCity(t, repo, "1.1.1.1", "One")
+	requireCity(t, repo, "3.3.3.3", "")
+	requireCity(t, repo, "3.3.3.3", "")
+	require.Equal(t, 2, counting.lookups)
+	require.Equal(t, CacheStats{Hits: 2, Misses: 2, Size: 2}, repo.CacheStats())
+
+	// negative result expires sooner
+	*now = now.Add(2 * time.Second)
+	requireCity(t, repo, "1.1.1.1", "One")
+	requireCity(t, repo, "3.3.3.3", "")
+	require.Equal(t, 3, counting.lookups)
+
+	*now = now.Add(time.Minute)
+	requireCity(t, repo, "1.1.1.1", "One")
+	require.Equal(t, 4, counting.lookups)
+}
+
+func TestCachedRepo_Eviction(t *testing.T) {
+	repo, counting, _ := newTestCachedRepo(t, CacheOptions{Size: 2, TTL: time.Minute, NegativeTTL: time.Minute})
+
+	requireCity(t, repo, "1.1.1.1", "One")
+	requireCity(t, repo, "2.2.2.2", "Two")
+	requireCity(t, repo, "1.1.1.1", "One")
+	// 2.2.2.2 is the least recently used one
+	requireCity(t, repo, "3.3.3.3", "")
+	require.Equal(t, 3, counting.lookups)
+	requireCity(t, repo, "1.1.1.1", "One")
+	require.Equal(t, 3, counting.lookups)
+	requireCity(t, repo, "2.2.2.2", "Two")
+	require.Equal(t, 4, counting.lookups)
+	require.Equal(t, 2, repo.CacheStats().Size)
+}
+
+func TestCachedRepo_InvalidatedByImport(t *testing.T) {
+	repo, counting, _ := newTestCachedRepo(t, CacheOptions{Size: 10, TTL: time.Minute, NegativeTTL: time.Minute})
+
+	requireCity(t, repo, "1.1.1.1", "One")
+	requireCity(t, repo, "3.3.3.3", "")
+
+	// dataset which isn't promoted doesn't change lookups
+	result := importSlices(t, repo, ImportOptions{Mode: ImportModeReplace}, geolocationSlice(
+		geolocation("1.1.1.1", "NewOne"),
+		geolocation("3.3.3.3", "Three"),
+	))
+	requireCity(t, repo, "1.1.1.1", "One")
+	require.Equal(t, 2, counting.lookups)
+
+	require.Nil(t, repo.PromoteDataset(context.Background(), result.DatasetID))
+	require.Equal(t, 0, repo.CacheStats().Size)
+	requireCity(t, repo, "1.1.1.1", "NewOne")
+	requireCity(t, repo, "3.3.3.3", "Three")
+
+	importSlices(t, repo, ImportOptions{Mode: ImportModeMerge, Promote: true}, geolocationSlice(
+		geolocation("3.3.3.3", "NewThree"),
+	))
+	requireCity(t, repo, "3.3.3.3", "NewThree")
+
+	_, err := repo.RollbackDataset(context.Background())
+	require.Nil(t, err)
+	requireCity(t, repo, "3.3.3.3", "Three")
+}
+
+func TestCachedRepo_LocateIPs(t *testing.T) {
+	repo, counting, _ := newTestCachedRepo(t, CacheOptions{Size: 10, TTL: time.Minute, NegativeTTL: time.Minute})
+
+	requireCity(t, repo, "1.1.1.1", "One")
+	locations, err := repo.LocateIPs(context.Background(), []string{"1.1.1.1", "2.2.2.2", "3.3.3.3"})
+	require.Nil(t, err)
+	require.Len(t, locations, 2)
+	require.Equal(t, "One", locations["1.1.1.1"].City.String)
+	require.Equal(t, "Two", locations["2.2.2.2"].City.String)
+	require.Equal(t, 3, counting.lookups)
+
+	locations, err = repo.LocateIPs(context.Background(), []string{"1.1.1.1", "2.2.2.2", "3.3.3.3"})
+	require.Nil(t, err)
+	require.Len(t, locations, 2)
+	require.Equal(t, 3, counting.lookups)
+}
+
+func TestCachedRepo_Stats(t *testing.T) {
+	repo, counting, _ := newTestCachedRepo(t, CacheOptions{Size: 10, TTL: time.Minute})
+
+	stats, err := repo.Stats(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 2, stats.Records)
+	_, err = repo.Stats(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, int64(1), counting.stats.Load())
+
+	// stats are recomputed in background after import
+	err = repo.AddGeolocationSlice(context.Background(), geolocationSlice(geolocation("3.3.3.3", "Three")))
+	require.Nil(t, err)
+	require.Eventually(t, func() bool {
+		return counting.stats.Load() == 2
+	}, time.Second, time.Millisecond)
+	require.Eventually(t, func() bool {
+		stats, err = repo.Stats(context.Background())
+		return err == nil && stats.Records == 3
+	}, time.Second, time.Millisecond)
+	require.Equal(t, int64(2), counting.stats.Load())
+
+	cities, err := repo.CityStats(context.Background(), "", 1)
+	require.Nil(t, err)
+	require.Len(t, cities, 1)
+}
+
+func TestCachedRepo_CityStats(t *testing.T) {
+	repo, counting, _ := newTestCachedRepo(t, CacheOptions{Size: 10, TTL: time.Minute})
+	ctx := context.Background()
+
+	// cities of country are cached once whatever limit is
+	for limit, expected := range map[int]int{1: 1, 2: 2, 3: 2} {
+		cities, err := repo.CityStats(ctx, "", limit)
+		require.Nil(t, err)
+		require.Len(t, cities, expected)
+	}
+	require.Equal(t, int64(1), counting.cities.Load())
+	cities, err := repo.CityStats(ctx, "", 1)
+	require.Nil(t, err)
+	cities = append(cities, CityStats{City: "Appended"})
+	cities, err = repo.CityStats(ctx, "", 2)
+	require.Nil(t, err)
+	require.NotEqual(t, "Appended", cities[1].City)
+
+	for _, code := range []string{"NP", "RU", "BO", "SK"} {
+		_, err = repo.CityStats(ctx, code, 1)
+		require.Nil(t, err)
+	}
+	require.Equal(t, int64(5), counting.cities.Load())
+
+	// cached cities are recomputed one by one
+	repo.Invalidate()
+	require.Eventually(t, func() bool {
+		return counting.cities.Load() == 10
+	}, time.Second, time.Millisecond)
+	require.Equal(t, int64(1), counting.maxRunning.Load())
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"github.com/MaximChernomorov/challenge-test/pkg/importer"
+	"github.com/volatiletech/null/v8"
+)
+
+const countriesTable = "countries"
+
+// Country is row of countries reference table, geolocation refers to it by alpha-2 code
+type Country struct {
+	// Code is ISO 3166-1 alpha-2 code
+	Code string
+	// Alpha3 is ISO 3166-1 alpha-3 code
+	Alpha3 string
+	// Numeric is ISO 3166-1 numeric code with leading zeros
+	Numeric string
+	// Name is canonical english short name
+	Name string
+	// Continent is two letter continent code: AF, AN, AS, EU, NA, OC or SA
+	Continent string
+}
+
+// countryColumns are columns of country joined to geolocation, they are null if geolocation refers to no country
+type countryColumns struct {
+	Alpha3    null.String `boil:"country_alpha3"`
+	Numeric   null.String `boil:"country_numeric_code"`
+	Name      null.String `boil:"country_name"`
+	Continent null.String `boil:"country_continent"`
+}
+
+// countryColumnsSelect selects countryColumns of countries table aliased as c
+const countryColumnsSelect = `c.alpha3 as country_alpha3, c.numeric_code as country_numeric_code, ` +
+	`c.name as country_name, c.continent as country_continent`
+
+func (columns countryColumns) country(code null.String) *Country {
+	if !code.Valid || !columns.Alpha3.Valid {
+		return nil
+	}
+
+	return &Country{
+		Code:      code.String,
+		Alpha3:    columns.Alpha3.String,
+		Numeric:   columns.Numeric.String,
+		Name:      columns.Name.String,
+		Continent: columns.Continent.String,
+	}
+}
+
+// referenceCountry returns country by alpha-2 code from reference table embedded into importer, it has the same
+// rows as countries table
+func referenceCountry(code null.String) *Country {
+	if !code.Valid {
+		return nil
+	}
+	country, found := importer.LookupCountry(code.String)
+	if !found {
+		return nil
+	}
+
+	return &Country{
+		Code:      country.Code,
+		Alpha3:    country.Alpha3,
+		Numeric:   country.Numeric,
+		Name:      country.Name,
+		Continent: country.Continent,
+	}
+}
@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository/model"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+const datasetsTable = "datasets"
+
+var ErrDatasetNotFound = errors.New("dataset not found")
+
+// activeDatasetCondition limits geolocations query to the dataset served to readers
+var activeDatasetCondition = fmt.Sprintf(
+	"%s = (select id from %s where is_active)",
+	model.GeolocationTableColumns.DatasetID,
+	datasetsTable,
+)
+
+// ImportMode defines how imported rows are combined with the active dataset into the new one
+type ImportMode string
+
+const (
+	// ImportModeAppend copies active dataset and adds imported rows, fails if some IP address already exists
+	ImportModeAppend ImportMode = "append"
+	// ImportModeMerge copies active dataset, adds new and updates changed IP addresses
+	ImportModeMerge ImportMode = "merge"
+	// ImportModeReplace creates dataset from imported rows only
+	ImportModeReplace ImportMode = "replace"
+)
+
+// ImportOptions controls how import builds new dataset
+type ImportOptions struct {
+	Mode ImportMode
+	// DeleteMissing skips IP addresses absent in imported rows while copying active dataset, merge mode only
+	DeleteMissing bool
+	// Promote makes new dataset active right after it is loaded
+	Promote bool
+	// KeepDatasets is count of the newest datasets kept after import, the active one is always kept. Zero keeps all
+	KeepDatasets int
+}
+
+// ImportStats describes difference between new dataset and the one which was active during import
+type ImportStats struct {
+	Inserted  int
+	Updated   int
+	Unchanged int
+	Deleted   int
+}
+
+// ImportResult describes dataset created by import
+type ImportResult struct {
+	ImportStats
+	DatasetID int
+	Pruned    int
+}
+
+// Dataset is a version of geolocations data, only one dataset is served to readers at a time
+type Dataset struct {
+	ID         int       `boil:"id" json:"id"`
+	RowsCount  int       `boil:"rows_count" json:"rows_count"`
+	IsActive   bool      `boil:"is_active" json:"is_active"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at"`
+	PromotedAt null.Time `boil:"promoted_at" json:"promoted_at,omitempty"`
+}
+
+// importGeolocationBatches loads batches into new dataset according to options
+func importGeolocationBatches(
+	ctx context.Context,
+	tx *sql.Tx,
+	batches <-chan GeolocationSlice,
+	options ImportOptions,
+) (ImportResult, error) {
+	result := ImportResult{}
+	activeID, err := activeDatasetID(ctx, tx)
+	if err != nil {
+		return result, err
+	}
+	err = tx.QueryRowContext(ctx, fmt.Sprintf(`insert into %s default values returning id`, datasetsTable)).
+		Scan(&result.DatasetID)
+	if err != nil {
+		return result, errors.Wrap(err, "failed to create dataset")
+	}
+
+	switch options.Mode {
+	case ImportModeAppend:
+		result.Unchanged, err = copyDataset(ctx, tx, activeID, result.DatasetID)
+		if err != nil {
+			return result, err
+		}
+		result.Inserted, err = copyGeolocationBatches(ctx, tx, model.TableNames.Geolocations, result.DatasetID, batches)
+	case ImportModeReplace:
+		result.Inserted, err = copyGeolocationBatches(ctx, tx, model.TableNames.Geolocations, result.DatasetID, batches)
+	case ImportModeMerge:
+		result.ImportStats, err = mergeGeolocationBatches(ctx, tx, batches, activeID, result.DatasetID, options.DeleteMissing)
+	default:
+		err = errors.Errorf("unknown import mode %q", options.Mode)
+	}
+	if err != nil {
+		return result, err
+	}
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(
+		`update %s set rows_count = (select count(*) from %s where %s = $1) where id = $1`,
+		datasetsTable,
+		model.TableNames.Geolocations,
+		model.GeolocationColumns.DatasetID,
+	), result.DatasetID)
+	if err != nil {
+		return result, errors.Wrap(err, "failed to count dataset rows")
+	}
+
+	if options.Promote {
+		err = promoteDataset(ctx, tx, result.DatasetID)
+	}
+
+	return result, err
+}
+
+// activeDatasetID returns id of the active dataset or zero if there is none
+func activeDatasetID(ctx context.Context, tx *sql.Tx) (int, error) {
+	var id int
+	err := tx.QueryRowContext(ctx, fmt.Sprintf(`select coalesce(max(id), 0) from %s where is_active`, datasetsTable)).
+		Scan(&id)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get active dataset")
+	}
+
+	return id, nil
+}
+
+// copyDataset copies rows of source dataset into target one and returns count of copied rows. Datasets don't share
+// rows, so every import but replace rewrites the whole active dataset
+func copyDataset(ctx context.Context, tx *sql.Tx, sourceID, targetID int) (int, error) {
+	copied, err := execAffected(ctx, tx, fmt.Sprintf(
+		`insert into %[1]s
+			(dataset_id, ip_address, country_code, country, city, coordinates, mystery_value, created_at, country_alpha2,
+			import_id)
+		select $2, ip_address, country_code, country, city, coordinates, mystery_value, created_at, country_alpha2,
+			import_id
+		from %[1]s
+		where dataset_id = $1`,
+		model.TableNames.Geolocations,
+	), sourceID, targetID)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to copy active dataset")
+	}
+
+	return copied, nil
+}
+
+// promoteDataset makes dataset active, readers switch to it once transaction is committed
+func promoteDataset(ctx context.Context, tx *sql.Tx, id int) error {
+	_, err := tx.ExecContext(ctx, fmt.Sprintf(`update %s set is_active = false where is_active`, datasetsTable))
+	if err != nil {
+		return errors.Wrap(err, "failed to deactivate dataset")
+	}
+	promoted, err := execAffected(ctx, tx, fmt.Sprintf(
+		`update %s set is_active = true, promoted_at = now() where id = $1`,
+		datasetsTable,
+	), id)
+	if err != nil {
+		return errors.Wrap(err, "failed to activate dataset")
+	}
+	if promoted == 0 {
+		return errors.Wrapf(ErrDatasetNotFound, "dataset %d", id)
+	}
+	// listeners get notification only when transaction is committed
+	_, err = tx.ExecContext(ctx, `select pg_notify($1, $2::text)`, datasetsChannel, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to notify about promoted dataset")
+	}
+
+	return nil
+}
+
+// rollbackDataset activates the newest dataset created before the active one, datasets loaded without promotion are
+// skipped as nobody reviewed them
+func rollbackDataset(ctx context.Context, tx *sql.Tx) (int, error) {
+	activeID, err := activeDatasetID(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+	var previousID int
+	err = tx.QueryRowContext(ctx, fmt.Sprintf(
+		`select coalesce(max(id), 0) from %s where id < $1 and promoted_at is not null`,
+		datasetsTable,
+	), activeID).Scan(&previousID)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get previous dataset")
+	}
+	if previousID == 0 {
+		return 0, errors.Wrap(ErrDatasetNotFound, "no dataset to roll back to")
+	}
+
+	return previousID, promoteDataset(ctx, tx, previousID)
+}
+
+// pruneDatasets deletes all datasets except the active one and keep newest ones, returns count of deleted datasets
+func pruneDatasets(ctx context.Context, tx *sql.Tx, keep int) (int, error) {
+	pruned, err := execAffected(ctx, tx, fmt.Sprintf(
+		`with outdated as (
+			select id from %[1]s
+			where not is_active and id not in (select id from %[1]s order by id desc limit $1)
+		), deleted_rows as (
+			delete from %[2]s where %[3]s in (select id from outdated)
+		)
+		delete from %[1]s where id in (select id from outdated)`,
+		datasetsTable,
+		model.TableNames.Geolocations,
+		model.GeolocationColumns.DatasetID,
+	), keep)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete outdated datasets")
+	}
+
+	return pruned, nil
+}
+
+func listDatasets(ctx context.Context, conn *sql.DB) ([]Dataset, error) {
+	datasets := make([]Dataset, 0)
+	err := queries.Raw(fmt.Sprintf(
+		`select id, rows_count, is_active, created_at, promoted_at from %s order by id desc`,
+		datasetsTable,
+	)).Bind(ctx, conn, &datasets)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list datasets")
+	}
+
+	return datasets, nil
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository/model"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const defaultExportBatchSize = 10000
+
+// ExportOptions controls which rows of the active dataset are exported
+type ExportOptions struct {
+	// BatchSize is max count of rows passed to callback at once
+	BatchSize int
+	// CountryCode limits export to rows of country, it is matched ignoring case against code returned to clients:
+	// code of reference country if row refers to one and imported code otherwise
+	CountryCode string
+	// ImportID limits export to rows written by import run, rows of all imports are exported if it is zero
+	ImportID int
+}
+
+func (options ExportOptions) batchSize() int {
+	if options.BatchSize < 1 {
+		return defaultExportBatchSize
+	}
+
+	return options.BatchSize
+}
+
+// filters returns query mods of options filters
+func (options ExportOptions) filters() []qm.QueryMod {
+	filters := make([]qm.QueryMod, 0, 2)
+	if len(options.CountryCode) != 0 {
+		filters = append(filters, qm.Where(fmt.Sprintf("upper(coalesce(%s, %s)) = upper(?)",
+			model.GeolocationColumns.CountryAlpha2, model.GeolocationColumns.CountryCode), options.CountryCode))
+	}
+	if options.ImportID != 0 {
+		filters = append(filters, model.GeolocationWhere.ImportID.EQ(null.IntFrom(options.ImportID)))
+	}
+
+	return filters
+}
+
+// matches checks row against options filters in memory
+func (options ExportOptions) matches(row *model.Geolocation) bool {
+	if len(options.CountryCode) != 0 {
+		location := Geolocation{Geolocation: *row, CountryRef: referenceCountry(row.CountryAlpha2)}
+		if !strings.EqualFold(countryCode(location), options.CountryCode) {
+			return false
+		}
+	}
+
+	return options.ImportID == 0 || row.ImportID == null.IntFrom(options.ImportID)
+}
+
+// exportGeolocations reads rows of active dataset matching options filters in batches ordered by id. Reading is done
+// in repeatable read transaction, so all batches belong to the same dataset even if it is replaced or pruned meanwhile
+func exportGeolocations(
+	ctx context.Context,
+	conn *sql.DB,
+	options ExportOptions,
+	fn func(GeolocationSlice) error,
+) error {
+	tx, err := conn.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return errors.Wrap(err, "failed to start transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	datasetID, err := activeDatasetID(ctx, tx)
+	if err != nil {
+		return err
+	}
+	lastID := 0
+	for {
+		batch, err := model.Geolocations(append(
+			options.filters(),
+			model.GeolocationWhere.DatasetID.EQ(datasetID),
+			model.GeolocationWhere.ID.GT(lastID),
+			qm.OrderBy(model.GeolocationColumns.ID),
+			qm.Limit(options.batchSize()),
+		)...).All(ctx, tx)
+		if err != nil {
+			return errors.Wrap(err, "failed to read geo locations from db")
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+		lastID = batch[len(batch)-1].ID
+		err = fn(GeolocationSlice{GeolocationSlice: batch})
+		if err != nil {
+			return err
+		}
+	}
+}
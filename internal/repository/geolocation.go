@@ -13,6 +13,14 @@ import (
 // underlying model
 type Geolocation struct {
 	model.Geolocation
+	// CountryRef is reference country geolocation refers to by CountryAlpha2, nil if it refers to none
+	CountryRef *Country
+}
+
+// LatLon returns latitude and longitude lookup APIs respond with. Coordinates are stored as point(latitude, longitude)
+// but REST api has always responded with them swapped, so gRPC api does the same to keep both APIs consistent
+func (geolocation Geolocation) LatLon() (float64, float64) {
+	return geolocation.Coordinates.Y, geolocation.Coordinates.X
 }
 
 // GeolocationSlice to hide implementation we should operate with this struct outside of repository instead
@@ -25,53 +33,84 @@ func (s *GeolocationSlice) GetLength() int {
 	return len(s.GeolocationSlice)
 }
 
-func (s *GeolocationSlice) insertAll(ctx context.Context, conn *sql.DB) error {
-	if len(s.GeolocationSlice) == 0 {
-		return nil
-	}
+// inTx runs fn in transaction which is committed if fn succeeds and rolled back otherwise
+func inTx(ctx context.Context, conn *sql.DB, fn func(tx *sql.Tx) error) error {
 	tx, err := conn.BeginTx(ctx, nil)
 	if err != nil {
 		return errors.Wrap(err, "failed to start transaction")
 	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = fn(tx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+// copyGeolocationBatches writes batches into table as dataset rows with single COPY statement until channel is
+// closed and returns count of written rows. Fails if ctx is cancelled before channel is closed, so the caller's
+// transaction is rolled back
+func copyGeolocationBatches(
+	ctx context.Context,
+	tx *sql.Tx,
+	table string,
+	datasetID int,
+	batches <-chan GeolocationSlice,
+) (int, error) {
 	statement, err := tx.Prepare(pq.CopyIn(
-		model.TableNames.Geolocations,
+		table,
+		model.GeolocationColumns.DatasetID,
 		model.GeolocationColumns.City,
 		model.GeolocationColumns.Country,
 		model.GeolocationColumns.CountryCode,
 		model.GeolocationColumns.IPAddress,
 		model.GeolocationColumns.Coordinates,
-		model.GeolocationColumns.MysteryValue))
+		model.GeolocationColumns.MysteryValue,
+		model.GeolocationColumns.CountryAlpha2,
+		model.GeolocationColumns.ImportID))
 	if err != nil {
-		return errors.Wrap(err, "failed to prepare transaction")
+		return 0, errors.Wrap(err, "failed to prepare statement")
 	}
 
-	for _, geolocation := range s.GeolocationSlice {
-		_, err = statement.Exec(
-			geolocation.City,
-			geolocation.Country,
-			geolocation.CountryCode,
-			geolocation.IPAddress,
-			geolocation.Coordinates,
-			geolocation.MysteryValue)
-		if err != nil {
-			return errors.Wrap(err, "failed to execute statement")
+	count := 0
+	for batch := range batches {
+		for _, geolocation := range batch.GeolocationSlice {
+			_, err = statement.Exec(
+				datasetID,
+				geolocation.City,
+				geolocation.Country,
+				geolocation.CountryCode,
+				geolocation.IPAddress,
+				geolocation.Coordinates,
+				geolocation.MysteryValue,
+				geolocation.CountryAlpha2,
+				geolocation.ImportID)
+			if err != nil {
+				return 0, errors.Wrap(err, "failed to execute statement")
+			}
+			count++
 		}
 	}
+	if ctx.Err() != nil {
+		return 0, errors.Wrap(ctx.Err(), "import cancelled")
+	}
 
 	_, err = statement.Exec()
 	if err != nil {
-		return errors.Wrap(err, "failed to execute statement")
+		return 0, errors.Wrap(err, "failed to execute statement")
 	}
 
 	err = statement.Close()
 	if err != nil {
-		return errors.Wrap(err, "failed to close statement")
+		return 0, errors.Wrap(err, "failed to close statement")
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		return errors.Wrap(err, "failed to commit transaction")
-	}
-
-	return nil
+	return count, nil
 }
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+const importsTable = "imports"
+
+// ImportStatus is state of import run
+type ImportStatus string
+
+const (
+	// ImportStatusRunning is status of import which isn't finished yet or was killed without recording its result
+	ImportStatusRunning   ImportStatus = "running"
+	ImportStatusSucceeded ImportStatus = "succeeded"
+	ImportStatusFailed    ImportStatus = "failed"
+)
+
+// DiscardedByReason is count of discarded rows by reason, it is stored as json object
+type DiscardedByReason map[string]int
+
+func (counts DiscardedByReason) Value() (driver.Value, error) {
+	if counts == nil {
+		return []byte("{}"), nil
+	}
+
+	return json.Marshal(counts)
+}
+
+func (counts *DiscardedByReason) Scan(value interface{}) error {
+	var data []byte
+	switch typed := value.(type) {
+	case nil:
+		*counts = nil
+		return nil
+	case []byte:
+		data = typed
+	case string:
+		data = []byte(typed)
+	default:
+		return errors.Errorf("unsupported discarded by reason type %T", value)
+	}
+
+	return json.Unmarshal(data, counts)
+}
+
+// ImportRun is recorded run of import, rows written by import refer to it by ImportID
+type ImportRun struct {
+	ID     int    `boil:"id" json:"id"`
+	Source string `boil:"source" json:"source"`
+	// Checksum is sha256 of source bytes as they are read, e.g. before decompression, it is known once import
+	// succeeds
+	Checksum          null.String       `boil:"checksum" json:"checksum,omitempty"`
+	Status            ImportStatus      `boil:"status" json:"status"`
+	StartedAt         time.Time         `boil:"started_at" json:"started_at"`
+	FinishedAt        null.Time         `boil:"finished_at" json:"finished_at,omitempty"`
+	Accepted          int               `boil:"accepted" json:"accepted"`
+	Discarded         int               `boil:"discarded" json:"discarded"`
+	DiscardedByReason DiscardedByReason `boil:"discarded_by_reason" json:"discarded_by_reason"`
+	// DatasetID is id of dataset created by import, it is null if import failed or dataset is pruned
+	DatasetID null.Int    `boil:"dataset_id" json:"dataset_id,omitempty"`
+	Error     null.String `boil:"error" json:"error,omitempty"`
+}
+
+func startImport(ctx context.Context, conn *sql.DB, source string) (ImportRun, error) {
+	run := ImportRun{Source: source, Status: ImportStatusRunning}
+	err := conn.QueryRowContext(ctx, fmt.Sprintf(
+		`insert into %s (source, status) values ($1, $2) returning id, started_at`,
+		importsTable,
+	), source, run.Status).Scan(&run.ID, &run.StartedAt)
+	if err != nil {
+		return run, errors.Wrap(err, "failed to record import")
+	}
+
+	return run, nil
+}
+
+func finishImport(ctx context.Context, conn *sql.DB, run ImportRun) error {
+	_, err := conn.ExecContext(ctx, fmt.Sprintf(
+		`update %s
+		set checksum = $2, status = $3, finished_at = now(), accepted = $4, discarded = $5,
+			discarded_by_reason = $6, dataset_id = $7, error = $8
+		where id = $1`,
+		importsTable,
+	), run.ID, run.Checksum, run.Status, run.Accepted, run.Discarded, run.DiscardedByReason, run.DatasetID, run.Error)
+	if err != nil {
+		return errors.Wrapf(err, "failed to record result of import %d", run.ID)
+	}
+
+	return nil
+}
+
+func listImports(ctx context.Context, conn *sql.DB, limit int) ([]ImportRun, error) {
+	runs := make([]ImportRun, 0)
+	if limit < 1 {
+		return runs, nil
+	}
+	err := queries.Raw(fmt.Sprintf(
+		`select id, source, checksum, status, started_at, finished_at, accepted, discarded, discarded_by_reason,
+			dataset_id, error
+		from %s
+		order by id desc
+		limit $1`,
+		importsTable,
+	), limit).Bind(ctx, conn, &runs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list imports")
+	}
+
+	return runs, nil
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// lruCache is size bounded cache which evicts the least recently used entries, every entry has its own expiration
+// time. It is safe for concurrent use
+type lruCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  *list.List
+	index    map[string]*list.Element
+	now      func() time.Time
+}
+
+type lruEntry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+}
+
+func newLRUCache(capacity int) *lruCache {
+	return &lruCache{
+		capacity: capacity,
+		entries:  list.New(),
+		index:    make(map[string]*list.Element),
+		now:      time.Now,
+	}
+}
+
+// Get returns value if it is cached and isn't expired yet
+func (cache *lruCache) Get(key string) (interface{}, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	element, exists := cache.index[key]
+	if !exists {
+		return nil, false
+	}
+	entry := element.Value.(*lruEntry)
+	if !cache.now().Before(entry.expiresAt) {
+		cache.entries.Remove(element)
+		delete(cache.index, key)
+		return nil, false
+	}
+	cache.entries.MoveToFront(element)
+
+	return entry.value, true
+}
+
+// Set caches value for ttl, the least recently used entry is evicted if cache is full
+func (cache *lruCache) Set(key string, value interface{}, ttl time.Duration) {
+	if cache.capacity <= 0 || ttl <= 0 {
+		return
+	}
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	expiresAt := cache.now().Add(ttl)
+	if element, exists := cache.index[key]; exists {
+		entry := element.Value.(*lruEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		cache.entries.MoveToFront(element)
+		return
+	}
+	if cache.entries.Len() >= cache.capacity {
+		oldest := cache.entries.Back()
+		cache.entries.Remove(oldest)
+		delete(cache.index, oldest.Value.(*lruEntry).key)
+	}
+	cache.index[key] = cache.entries.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+}
+
+// Purge removes all entries
+func (cache *lruCache) Purge() {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.entries.Init()
+	cache.index = make(map[string]*list.Element)
+}
+
+// Len returns count of cached entries including expired ones which weren't evicted yet
+func (cache *lruCache) Len() int {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	return cache.entries.Len()
+}
@@ -0,0 +1,683 @@
+package repository
+
+import (
+	"context"
+	"math"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository/model"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+)
+
+// MemoryRepo keeps datasets in memory, it follows PostgresRepo semantics and is safe for concurrent use
+type MemoryRepo struct {
+	mu       sync.RWMutex
+	datasets []*memoryDataset
+	// active is the dataset served to readers, nil if there is none
+	active        *memoryDataset
+	lastDatasetID int
+	lastRowID     int
+	// imports are recorded import runs, the oldest first
+	imports []ImportRun
+}
+
+type memoryDataset struct {
+	Dataset
+	// rows are keyed by network in CIDR notation
+	rows map[string]*model.Geolocation
+	// prefixLengths are network prefix lengths present in rows by address bits count, longest first
+	prefixLengths map[int][]int
+}
+
+func NewMemoryRepo() Repository {
+	return &MemoryRepo{}
+}
+
+// AddGeolocationSlice stores geolocation slice as new active dataset which also contains rows of the previous one
+func (repo *MemoryRepo) AddGeolocationSlice(ctx context.Context, geolocationSlice GeolocationSlice) error {
+	batches := make(chan GeolocationSlice, 1)
+	batches <- geolocationSlice
+	close(batches)
+	_, err := repo.ImportGeolocationBatches(ctx, batches, ImportOptions{Mode: ImportModeAppend, Promote: true})
+
+	return err
+}
+
+func (repo *MemoryRepo) ImportGeolocationBatches(
+	ctx context.Context,
+	batches <-chan GeolocationSlice,
+	options ImportOptions,
+) (ImportResult, error) {
+	result := ImportResult{}
+	repo.mu.RLock()
+	active := repo.active
+	repo.mu.RUnlock()
+
+	dataset := &memoryDataset{rows: make(map[string]*model.Geolocation)}
+	var err error
+	switch options.Mode {
+	case ImportModeAppend:
+		result.Unchanged = dataset.copyRows(active)
+		result.Inserted, err = dataset.addBatches(batches, false)
+	case ImportModeReplace:
+		result.Inserted, err = dataset.addBatches(batches, false)
+	case ImportModeMerge:
+		result.ImportStats, err = dataset.merge(batches, active, options.DeleteMissing)
+	default:
+		err = errors.Errorf("unknown import mode %q", options.Mode)
+	}
+	if err != nil {
+		return result, err
+	}
+	if ctx.Err() != nil {
+		return result, errors.Wrap(ctx.Err(), "import cancelled")
+	}
+
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	repo.lastDatasetID++
+	dataset.ID = repo.lastDatasetID
+	dataset.RowsCount = len(dataset.rows)
+	dataset.CreatedAt = time.Now()
+	createdAt := null.TimeFrom(dataset.CreatedAt)
+	for _, row := range dataset.rows {
+		repo.lastRowID++
+		row.ID = repo.lastRowID
+		row.DatasetID = dataset.ID
+		if !row.CreatedAt.Valid {
+			row.CreatedAt = createdAt
+		}
+	}
+	repo.datasets = append(repo.datasets, dataset)
+	result.DatasetID = dataset.ID
+	if options.Promote {
+		repo.promote(dataset)
+	}
+	if options.KeepDatasets != 0 {
+		result.Pruned = repo.prune(options.KeepDatasets)
+	}
+
+	return result, nil
+}
+
+// LocateIP finds the most specific network containing IP in the active dataset
+func (repo *MemoryRepo) LocateIP(ctx context.Context, IP string) (Geolocation, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	return repo.locate(IP)
+}
+
+func (repo *MemoryRepo) LocateIPs(ctx context.Context, IPs []string) (map[string]Geolocation, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	locations := make(map[string]Geolocation, len(IPs))
+	for _, IP := range IPs {
+		location, err := repo.locate(IP)
+		if err == nil {
+			locations[IP] = location
+		}
+	}
+
+	return locations, nil
+}
+
+// NearestGeolocations scans the active dataset, datasets are never changed after creation, so rows are read without
+// lock
+func (repo *MemoryRepo) NearestGeolocations(
+	ctx context.Context,
+	latitude float64,
+	longitude float64,
+	limit int,
+) ([]NearbyGeolocation, error) {
+	repo.mu.RLock()
+	active := repo.active
+	repo.mu.RUnlock()
+	if active == nil || limit < 1 {
+		return nil, nil
+	}
+
+	nearby := make([]NearbyGeolocation, 0, len(active.rows))
+	for _, row := range active.rows {
+		nearby = append(nearby, NearbyGeolocation{
+			Geolocation: Geolocation{Geolocation: *row, CountryRef: referenceCountry(row.CountryAlpha2)},
+			DistanceKm:  distanceKm(latitude, longitude, row.Coordinates),
+		})
+	}
+
+	return rankNearby(nearby, limit), nil
+}
+
+// SearchGeolocations filters and sorts the active dataset on every call, datasets are never changed after creation,
+// so rows are read without lock
+func (repo *MemoryRepo) SearchGeolocations(ctx context.Context, options SearchOptions) (SearchResult, error) {
+	cursor, err := options.cursor()
+	if err != nil {
+		return SearchResult{}, err
+	}
+	var prefix *net.IPNet
+	if len(options.IPPrefix) != 0 {
+		prefix, err = parseNetwork(options.IPPrefix)
+		if err != nil {
+			return SearchResult{}, err
+		}
+	}
+	repo.mu.RLock()
+	active := repo.active
+	repo.mu.RUnlock()
+	if active == nil {
+		return SearchResult{}, nil
+	}
+
+	type sortedLocation struct {
+		Geolocation
+		value interface{}
+	}
+	// compare orders locations by sort value and id in options direction
+	compare := func(a sortedLocation, b sortedLocation) int {
+		result := compareSearchValues(a.value, b.value)
+		if result == 0 {
+			result = a.ID - b.ID
+		}
+		if options.Descending {
+			return -result
+		}
+		return result
+	}
+	var after *sortedLocation
+	if cursor != nil {
+		value, _ := parseSearchValue(cursor.Sort, cursor.Value)
+		after = &sortedLocation{Geolocation: Geolocation{Geolocation: model.Geolocation{ID: cursor.ID}}, value: value}
+	}
+	sorted := make([]sortedLocation, 0)
+	for _, row := range active.rows {
+		location := Geolocation{Geolocation: *row, CountryRef: referenceCountry(row.CountryAlpha2)}
+		if !options.matches(location, prefix) {
+			continue
+		}
+		value, err := parseSearchValue(options.sort(), searchValue(options.sort(), location))
+		if err != nil {
+			return SearchResult{}, err
+		}
+		sortedLocation := sortedLocation{Geolocation: location, value: value}
+		if after != nil && compare(sortedLocation, *after) <= 0 {
+			continue
+		}
+		sorted = append(sorted, sortedLocation)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return compare(sorted[i], sorted[j]) < 0 })
+
+	locations := make([]Geolocation, 0, options.limit()+1)
+	for _, location := range sorted {
+		if len(locations) > options.limit() {
+			break
+		}
+		locations = append(locations, location.Geolocation)
+	}
+
+	return options.page(locations), nil
+}
+
+// ExportGeolocations passes rows of the active dataset matching options filters ordered by network, datasets are
+// never changed after creation, so rows are read without lock
+func (repo *MemoryRepo) ExportGeolocations(
+	ctx context.Context,
+	options ExportOptions,
+	fn func(GeolocationSlice) error,
+) error {
+	repo.mu.RLock()
+	active := repo.active
+	repo.mu.RUnlock()
+	if active == nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(active.rows))
+	for key, row := range active.rows {
+		if options.matches(row) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for start := 0; start < len(keys); start += options.batchSize() {
+		end := start + options.batchSize()
+		if end > len(keys) {
+			end = len(keys)
+		}
+		batch := make(model.GeolocationSlice, 0, end-start)
+		for _, key := range keys[start:end] {
+			rowCopy := *active.rows[key]
+			batch = append(batch, &rowCopy)
+		}
+		err := fn(GeolocationSlice{GeolocationSlice: batch})
+		if err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// locate should be called under read lock
+func (repo *MemoryRepo) locate(IP string) (Geolocation, error) {
+	ip := net.ParseIP(IP)
+	if ip == nil {
+		return Geolocation{}, errors.New("invalid IP address")
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	bits := len(ip) * 8
+
+	if repo.active == nil {
+		return Geolocation{}, ErrLocationNotFound
+	}
+	for _, prefixLength := range repo.active.prefixLengths[bits] {
+		mask := net.CIDRMask(prefixLength, bits)
+		network := &net.IPNet{IP: ip.Mask(mask), Mask: mask}
+		if row, exists := repo.active.rows[network.String()]; exists {
+			return Geolocation{Geolocation: *row, CountryRef: referenceCountry(row.CountryAlpha2)}, nil
+		}
+	}
+
+	return Geolocation{}, ErrLocationNotFound
+}
+
+// Stats aggregates the active dataset on every call, datasets are never changed after creation, so rows are read
+// without lock
+func (repo *MemoryRepo) Stats(ctx context.Context) (Stats, error) {
+	repo.mu.RLock()
+	active := repo.active
+	repo.mu.RUnlock()
+	result := Stats{}
+	if active == nil {
+		return result, nil
+	}
+
+	result.DatasetID = active.ID
+	result.Records = len(active.rows)
+	countries := make(map[string]struct{})
+	cities := make(map[[2]string]struct{})
+	for _, row := range active.rows {
+		location := Geolocation{Geolocation: *row, CountryRef: referenceCountry(row.CountryAlpha2)}
+		if code := countryCode(location); len(code) != 0 {
+			countries[code] = struct{}{}
+		}
+		if len(row.City.String) != 0 {
+			cities[[2]string{countryCode(location), row.City.String}] = struct{}{}
+		}
+		newest := result.NewestCreatedAt
+		if row.CreatedAt.Valid && (!newest.Valid || row.CreatedAt.Time.After(newest.Time)) {
+			result.NewestCreatedAt = row.CreatedAt
+		}
+	}
+	result.Countries = len(countries)
+	result.Cities = len(cities)
+
+	return result, nil
+}
+
+// CountryStats aggregates the active dataset on every call, see Stats
+func (repo *MemoryRepo) CountryStats(ctx context.Context) ([]CountryStats, error) {
+	repo.mu.RLock()
+	active := repo.active
+	repo.mu.RUnlock()
+	result := make([]CountryStats, 0)
+	if active == nil {
+		return result, nil
+	}
+
+	indexes := make(map[string]int)
+	cities := make(map[string]map[string]struct{})
+	for _, row := range active.rows {
+		location := Geolocation{Geolocation: *row, CountryRef: referenceCountry(row.CountryAlpha2)}
+		code := countryCode(location)
+		latitude, longitude := row.Coordinates.X, row.Coordinates.Y
+		index, exists := indexes[code]
+		if !exists {
+			index = len(result)
+			indexes[code] = index
+			cities[code] = make(map[string]struct{})
+			result = append(result, CountryStats{
+				Code:         code,
+				Name:         countryName(location),
+				MinLatitude:  latitude,
+				MinLongitude: longitude,
+				MaxLatitude:  latitude,
+				MaxLongitude: longitude,
+			})
+		}
+		country := &result[index]
+		country.Records++
+		if name := countryName(location); name < country.Name {
+			country.Name = name
+		}
+		if len(row.City.String) != 0 {
+			cities[code][row.City.String] = struct{}{}
+		}
+		country.MinLatitude = math.Min(country.MinLatitude, latitude)
+		country.MinLongitude = math.Min(country.MinLongitude, longitude)
+		country.MaxLatitude = math.Max(country.MaxLatitude, latitude)
+		country.MaxLongitude = math.Max(country.MaxLongitude, longitude)
+	}
+	for i := range result {
+		result[i].Cities = len(cities[result[i].Code])
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Records != result[j].Records {
+			return result[i].Records > result[j].Records
+		}
+		return result[i].Code < result[j].Code
+	})
+
+	return result, nil
+}
+
+// CityStats aggregates the active dataset on every call, see Stats
+func (repo *MemoryRepo) CityStats(ctx context.Context, code string, limit int) ([]CityStats, error) {
+	repo.mu.RLock()
+	active := repo.active
+	repo.mu.RUnlock()
+	result := make([]CityStats, 0)
+	if active == nil || limit < 1 {
+		return result, nil
+	}
+
+	indexes := make(map[CityStats]int)
+	for _, row := range active.rows {
+		if len(row.City.String) == 0 {
+			continue
+		}
+		city := CityStats{
+			CountryCode: countryCode(Geolocation{Geolocation: *row, CountryRef: referenceCountry(row.CountryAlpha2)}),
+			City:        row.City.String,
+		}
+		if len(code) != 0 && !strings.EqualFold(city.CountryCode, code) {
+			continue
+		}
+		index, exists := indexes[city]
+		if !exists {
+			index = len(result)
+			indexes[city] = index
+			result = append(result, city)
+		}
+		result[index].Records++
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Records != result[j].Records {
+			return result[i].Records > result[j].Records
+		}
+		if result[i].CountryCode != result[j].CountryCode {
+			return result[i].CountryCode < result[j].CountryCode
+		}
+		return result[i].City < result[j].City
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+
+	return result, nil
+}
+
+func (repo *MemoryRepo) ListDatasets(ctx context.Context) ([]Dataset, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	datasets := make([]Dataset, 0, len(repo.datasets))
+	for i := len(repo.datasets) - 1; i >= 0; i-- {
+		datasets = append(datasets, repo.datasets[i].Dataset)
+	}
+
+	return datasets, nil
+}
+
+func (repo *MemoryRepo) PromoteDataset(ctx context.Context, id int) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	for _, dataset := range repo.datasets {
+		if dataset.ID == id {
+			repo.promote(dataset)
+			return nil
+		}
+	}
+
+	return errors.Wrapf(ErrDatasetNotFound, "dataset %d", id)
+}
+
+func (repo *MemoryRepo) RollbackDataset(ctx context.Context) (int, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	var previous *memoryDataset
+	for _, dataset := range repo.datasets {
+		if repo.active != nil && dataset.ID >= repo.active.ID {
+			break
+		}
+		if dataset.PromotedAt.Valid {
+			previous = dataset
+		}
+	}
+	if previous == nil || repo.active == nil {
+		return 0, errors.Wrap(ErrDatasetNotFound, "no dataset to roll back to")
+	}
+	repo.promote(previous)
+
+	return previous.ID, nil
+}
+
+func (repo *MemoryRepo) StartImport(ctx context.Context, source string) (ImportRun, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	run := ImportRun{
+		ID:        len(repo.imports) + 1,
+		Source:    source,
+		Status:    ImportStatusRunning,
+		StartedAt: time.Now(),
+	}
+	repo.imports = append(repo.imports, run)
+
+	return run, nil
+}
+
+func (repo *MemoryRepo) FinishImport(ctx context.Context, run ImportRun) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if run.ID < 1 || run.ID > len(repo.imports) {
+		return errors.Errorf("failed to record result of import %d: import not found", run.ID)
+	}
+	run.StartedAt = repo.imports[run.ID-1].StartedAt
+	run.FinishedAt = null.TimeFrom(time.Now())
+	if run.DiscardedByReason == nil {
+		run.DiscardedByReason = DiscardedByReason{}
+	}
+	repo.imports[run.ID-1] = run
+
+	return nil
+}
+
+func (repo *MemoryRepo) ListImports(ctx context.Context, limit int) ([]ImportRun, error) {
+	runs := make([]ImportRun, 0)
+	if limit < 1 {
+		return runs, nil
+	}
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	for i := len(repo.imports) - 1; i >= 0 && len(runs) < limit; i-- {
+		runs = append(runs, repo.imports[i])
+	}
+
+	return runs, nil
+}
+
+func (repo *MemoryRepo) Close() error {
+	return nil
+}
+
+// promote should be called under write lock
+func (repo *MemoryRepo) promote(dataset *memoryDataset) {
+	if repo.active != nil {
+		repo.active.IsActive = false
+	}
+	dataset.IsActive = true
+	dataset.PromotedAt = null.TimeFrom(time.Now())
+	repo.active = dataset
+}
+
+// prune should be called under write lock
+func (repo *MemoryRepo) prune(keep int) int {
+	kept := make([]*memoryDataset, 0, keep+1)
+	keptIDs := make(map[int]bool, keep+1)
+	for i, dataset := range repo.datasets {
+		if dataset.IsActive || i >= len(repo.datasets)-keep {
+			kept = append(kept, dataset)
+			keptIDs[dataset.ID] = true
+		}
+	}
+	pruned := len(repo.datasets) - len(kept)
+	repo.datasets = kept
+	// import runs forget pruned datasets like postgres foreign key does
+	for i, run := range repo.imports {
+		if run.DatasetID.Valid && !keptIDs[run.DatasetID.Int] {
+			repo.imports[i].DatasetID = null.Int{}
+		}
+	}
+
+	return pruned
+}
+
+// copyRows copies rows of source dataset and returns count of copied rows
+func (dataset *memoryDataset) copyRows(source *memoryDataset) int {
+	if source == nil {
+		return 0
+	}
+	for key, row := range source.rows {
+		dataset.add(key, row)
+	}
+
+	return len(source.rows)
+}
+
+// addBatches adds rows from batches and returns count of added rows. If skipExisting is set, networks which are
+// already in dataset are skipped, otherwise they fail import
+func (dataset *memoryDataset) addBatches(batches <-chan GeolocationSlice, skipExisting bool) (int, error) {
+	added := 0
+	var err error
+	for batch := range batches {
+		// batches are always drained, so producer is never blocked
+		if err != nil {
+			continue
+		}
+		for _, row := range batch.GeolocationSlice {
+			key, parseErr := networkKey(row.IPAddress)
+			if parseErr != nil {
+				err = parseErr
+				break
+			}
+			if _, exists := dataset.rows[key]; exists {
+				if skipExisting {
+					continue
+				}
+				err = errors.Errorf("duplicate IP address %s", row.IPAddress)
+				break
+			}
+			dataset.add(key, row)
+			added++
+		}
+	}
+
+	return added, err
+}
+
+// merge builds dataset from batches and rows of active dataset absent in batches unless deleteMissing is set
+func (dataset *memoryDataset) merge(
+	batches <-chan GeolocationSlice,
+	active *memoryDataset,
+	deleteMissing bool,
+) (ImportStats, error) {
+	stats := ImportStats{}
+	_, err := dataset.addBatches(batches, true)
+	if err != nil {
+		return stats, err
+	}
+	activeRows := make(map[string]*model.Geolocation)
+	if active != nil {
+		activeRows = active.rows
+	}
+	for key, row := range dataset.rows {
+		activeRow, exists := activeRows[key]
+		switch {
+		case !exists:
+			stats.Inserted++
+		case isGeolocationChanged(activeRow, row):
+			stats.Updated++
+		default:
+			stats.Unchanged++
+		}
+	}
+	for key, row := range activeRows {
+		if _, exists := dataset.rows[key]; exists {
+			continue
+		}
+		if deleteMissing {
+			stats.Deleted++
+			continue
+		}
+		dataset.add(key, row)
+	}
+
+	return stats, nil
+}
+
+// add stores copy of row, so datasets never share rows
+func (dataset *memoryDataset) add(key string, row *model.Geolocation) {
+	rowCopy := *row
+	rowCopy.R = nil
+	dataset.rows[key] = &rowCopy
+
+	if dataset.prefixLengths == nil {
+		dataset.prefixLengths = make(map[int][]int)
+	}
+	_, network, _ := net.ParseCIDR(key)
+	prefixLength, bits := network.Mask.Size()
+	prefixLengths := dataset.prefixLengths[bits]
+	index := sort.Search(len(prefixLengths), func(i int) bool { return prefixLengths[i] <= prefixLength })
+	if index < len(prefixLengths) && prefixLengths[index] == prefixLength {
+		return
+	}
+	prefixLengths = append(prefixLengths, 0)
+	copy(prefixLengths[index+1:], prefixLengths[index:])
+	prefixLengths[index] = prefixLength
+	dataset.prefixLengths[bits] = prefixLengths
+}
+
+func isGeolocationChanged(old, new *model.Geolocation) bool {
+	return old.CountryCode != new.CountryCode ||
+		old.Country != new.Country ||
+		old.City != new.City ||
+		old.MysteryValue != new.MysteryValue ||
+		old.CountryAlpha2 != new.CountryAlpha2 ||
+		old.Coordinates != new.Coordinates
+}
+
+// networkKey normalizes single IP or CIDR network into CIDR notation
+func networkKey(address string) (string, error) {
+	if _, network, err := net.ParseCIDR(address); err == nil {
+		return network.String(), nil
+	}
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return "", errors.Errorf("invalid IP address %s", address)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	bits := len(ip) * 8
+
+	return (&net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}).String(), nil
+}
@@ -0,0 +1,520 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository/model"
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types/pgeo"
+)
+
+func geolocationSlice(rows ...*model.Geolocation) GeolocationSlice {
+	return GeolocationSlice{GeolocationSlice: rows}
+}
+
+func geolocation(ip, city string) *model.Geolocation {
+	return &model.Geolocation{
+		IPAddress:   ip,
+		CountryCode: null.StringFrom("RU"),
+		Country:     null.StringFrom("Morocco"),
+		City:        null.StringFrom(city),
+		Coordinates: pgeo.NewPoint(76.7892707471672, -8.617777079132821),
+	}
+}
+
+func importSlices(t *testing.T, repo Repository, options ImportOptions, slices ...GeolocationSlice) ImportResult {
+	batches := make(chan GeolocationSlice, len(slices))
+	for _, slice := range slices {
+		batches <- slice
+	}
+	close(batches)
+	result, err := repo.ImportGeolocationBatches(context.Background(), batches, options)
+	require.Nil(t, err)
+
+	return result
+}
+
+func requireCity(t *testing.T, repo Repository, ip, city string) {
+	location, err := repo.LocateIP(context.Background(), ip)
+	if len(city) == 0 {
+		require.ErrorIs(t, err, ErrLocationNotFound)
+		return
+	}
+	require.Nil(t, err)
+	require.Equal(t, city, location.City.String)
+}
+
+func TestMemoryRepo_LocateIP(t *testing.T) {
+	repo := NewMemoryRepo()
+	err := repo.AddGeolocationSlice(context.Background(), geolocationSlice(
+		geolocation("10.0.0.0/8", "Ten"),
+		geolocation("10.1.0.0/16", "TenOne"),
+		geolocation("10.1.2.3", "Host"),
+		geolocation("2001:db8::/32", "Documentation"),
+	))
+	require.Nil(t, err)
+
+	requireCity(t, repo, "10.200.0.1", "Ten")
+	requireCity(t, repo, "10.1.200.1", "TenOne")
+	requireCity(t, repo, "10.1.2.3", "Host")
+	requireCity(t, repo, "2001:db8::1", "Documentation")
+	requireCity(t, repo, "11.0.0.1", "")
+	requireCity(t, repo, "::ffff:10.1.2.3", "Host")
+
+	err = repo.AddGeolocationSlice(context.Background(), geolocationSlice(geolocation("10.1.2.3/32", "Duplicate")))
+	require.NotNil(t, err)
+	requireCity(t, repo, "10.1.2.3", "Host")
+}
+
+func TestMemoryRepo_LocateIPCountry(t *testing.T) {
+	repo := NewMemoryRepo()
+	referring := geolocation("10.0.0.0/8", "Ten")
+	referring.CountryAlpha2 = null.StringFrom("MA")
+	err := repo.AddGeolocationSlice(context.Background(), geolocationSlice(
+		referring,
+		geolocation("11.0.0.0/8", "Eleven"),
+	))
+	require.Nil(t, err)
+
+	location, err := repo.LocateIP(context.Background(), "10.0.0.1")
+	require.Nil(t, err)
+	require.Equal(t, &Country{
+		Code:      "MA",
+		Alpha3:    "MAR",
+		Numeric:   "504",
+		Name:      "Morocco",
+		Continent: "AF",
+	}, location.CountryRef)
+	location, err = repo.LocateIP(context.Background(), "11.0.0.1")
+	require.Nil(t, err)
+	require.Nil(t, location.CountryRef)
+}
+
+func TestMemoryRepo_ImportModes(t *testing.T) {
+	repo := NewMemoryRepo()
+	importSlices(t, repo, ImportOptions{Mode: ImportModeReplace, Promote: true}, geolocationSlice(
+		geolocation("1.1.1.1", "One"),
+		geolocation("2.2.2.2", "Two"),
+		geolocation("3.3.3.3", "Three"),
+	))
+
+	result := importSlices(t, repo, ImportOptions{Mode: ImportModeMerge, Promote: true}, geolocationSlice(
+		geolocation("1.1.1.1", "One"),
+		geolocation("2.2.2.2", "Changed"),
+		geolocation("4.4.4.4", "Four"),
+		geolocation("4.4.4.4", "Ignored"),
+	))
+	require.Equal(t, ImportStats{Inserted: 1, Updated: 1, Unchanged: 1}, result.ImportStats)
+	requireCity(t, repo, "2.2.2.2", "Changed")
+	requireCity(t, repo, "3.3.3.3", "Three")
+	requireCity(t, repo, "4.4.4.4", "Four")
+
+	result = importSlices(t, repo, ImportOptions{Mode: ImportModeMerge, DeleteMissing: true, Promote: true},
+		geolocationSlice(geolocation("1.1.1.1", "One")))
+	require.Equal(t, ImportStats{Unchanged: 1, Deleted: 3}, result.ImportStats)
+	requireCity(t, repo, "3.3.3.3", "")
+
+	result = importSlices(t, repo, ImportOptions{Mode: ImportModeAppend, Promote: true},
+		geolocationSlice(geolocation("5.5.5.5", "Five")))
+	require.Equal(t, ImportStats{Inserted: 1, Unchanged: 1}, result.ImportStats)
+	requireCity(t, repo, "1.1.1.1", "One")
+	requireCity(t, repo, "5.5.5.5", "Five")
+}
+
+func TestMemoryRepo_Datasets(t *testing.T) {
+	repo := NewMemoryRepo()
+	ctx := context.Background()
+	first := importSlices(t, repo, ImportOptions{Mode: ImportModeReplace, Promote: true},
+		geolocationSlice(geolocation("1.1.1.1", "First")))
+	second := importSlices(t, repo, ImportOptions{Mode: ImportModeReplace},
+		geolocationSlice(geolocation("1.1.1.1", "Second")))
+	requireCity(t, repo, "1.1.1.1", "First")
+
+	require.Nil(t, repo.PromoteDataset(ctx, second.DatasetID))
+	requireCity(t, repo, "1.1.1.1", "Second")
+	require.ErrorIs(t, repo.PromoteDataset(ctx, 100), ErrDatasetNotFound)
+
+	id, err := repo.RollbackDataset(ctx)
+	require.Nil(t, err)
+	require.Equal(t, first.DatasetID, id)
+	requireCity(t, repo, "1.1.1.1", "First")
+	_, err = repo.RollbackDataset(ctx)
+	require.ErrorIs(t, err, ErrDatasetNotFound)
+
+	third := importSlices(t, repo, ImportOptions{Mode: ImportModeReplace, KeepDatasets: 1},
+		geolocationSlice(geolocation("1.1.1.1", "Third")))
+	require.Equal(t, 1, third.Pruned)
+	datasets, err := repo.ListDatasets(ctx)
+	require.Nil(t, err)
+	require.Len(t, datasets, 2)
+	require.Equal(t, third.DatasetID, datasets[0].ID)
+	require.False(t, datasets[0].IsActive)
+	require.Equal(t, first.DatasetID, datasets[1].ID)
+	require.True(t, datasets[1].IsActive)
+	require.Equal(t, 1, datasets[1].RowsCount)
+}
+
+func TestMemoryRepo_RollbackSkipsNotPromoted(t *testing.T) {
+	repo := NewMemoryRepo()
+	ctx := context.Background()
+	first := importSlices(t, repo, ImportOptions{Mode: ImportModeReplace, Promote: true},
+		geolocationSlice(geolocation("1.1.1.1", "First")))
+	importSlices(t, repo, ImportOptions{Mode: ImportModeReplace},
+		geolocationSlice(geolocation("1.1.1.1", "Not promoted")))
+	importSlices(t, repo, ImportOptions{Mode: ImportModeReplace, Promote: true},
+		geolocationSlice(geolocation("1.1.1.1", "Third")))
+
+	id, err := repo.RollbackDataset(ctx)
+	require.Nil(t, err)
+	require.Equal(t, first.DatasetID, id)
+	requireCity(t, repo, "1.1.1.1", "First")
+}
+
+func TestMemoryRepo_CancelledImport(t *testing.T) {
+	repo := NewMemoryRepo()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	batches := make(chan GeolocationSlice, 1)
+	batches <- geolocationSlice(geolocation("1.1.1.1", "One"))
+	close(batches)
+
+	_, err := repo.ImportGeolocationBatches(ctx, batches, ImportOptions{Mode: ImportModeAppend, Promote: true})
+	require.ErrorIs(t, err, context.Canceled)
+	requireCity(t, repo, "1.1.1.1", "")
+}
+
+func TestMemoryRepo_LocateIPs(t *testing.T) {
+	repo := NewMemoryRepo()
+	err := repo.AddGeolocationSlice(context.Background(), geolocationSlice(
+		geolocation("10.0.0.0/8", "Ten"),
+		geolocation("10.1.2.3", "Host"),
+	))
+	require.Nil(t, err)
+
+	locations, err := repo.LocateIPs(context.Background(), []string{"10.1.2.3", "10.9.9.9", "11.0.0.1", "invalid"})
+	require.Nil(t, err)
+	require.Len(t, locations, 2)
+	require.Equal(t, "Host", locations["10.1.2.3"].City.String)
+	require.Equal(t, "Ten", locations["10.9.9.9"].City.String)
+}
+
+func TestMemoryRepo_ExportGeolocations(t *testing.T) {
+	repo := NewMemoryRepo()
+	err := repo.ExportGeolocations(context.Background(), ExportOptions{}, func(GeolocationSlice) error {
+		t.Fatal("empty repository exported rows")
+		return nil
+	})
+	require.Nil(t, err)
+
+	one := geolocation("1.1.1.1", "One")
+	one.CountryAlpha2 = null.StringFrom("MA")
+	three := geolocation("3.3.3.3", "Three")
+	three.ImportID = null.IntFrom(7)
+	importSlices(t, repo, ImportOptions{Mode: ImportModeReplace, Promote: true}, geolocationSlice(
+		one,
+		geolocation("2.2.2.2", "Two"),
+		three,
+	))
+	importSlices(t, repo, ImportOptions{Mode: ImportModeReplace}, geolocationSlice(geolocation("4.4.4.4", "Four")))
+
+	batchSizes := make([]int, 0)
+	cities := make([]string, 0)
+	err = repo.ExportGeolocations(context.Background(), ExportOptions{BatchSize: 2}, func(batch GeolocationSlice) error {
+		batchSizes = append(batchSizes, batch.GetLength())
+		for _, row := range batch.GeolocationSlice {
+			cities = append(cities, row.City.String)
+		}
+		return nil
+	})
+	require.Nil(t, err)
+	require.Equal(t, []int{2, 1}, batchSizes)
+	require.Equal(t, []string{"One", "Two", "Three"}, cities)
+
+	exportCities := func(options ExportOptions) []string {
+		cities := make([]string, 0)
+		err := repo.ExportGeolocations(context.Background(), options, func(batch GeolocationSlice) error {
+			for _, row := range batch.GeolocationSlice {
+				cities = append(cities, row.City.String)
+			}
+			return nil
+		})
+		require.Nil(t, err)
+		return cities
+	}
+	// row referring to reference country is exported by its code rather than by imported one
+	require.Equal(t, []string{"One"}, exportCities(ExportOptions{CountryCode: "ma"}))
+	require.Equal(t, []string{"Two", "Three"}, exportCities(ExportOptions{CountryCode: "RU"}))
+	require.Equal(t, []string{"Three"}, exportCities(ExportOptions{ImportID: 7}))
+	require.Empty(t, exportCities(ExportOptions{CountryCode: "MA", ImportID: 7}))
+
+	exportErr := errors.New("export failed")
+	err = repo.ExportGeolocations(context.Background(), ExportOptions{BatchSize: 2}, func(GeolocationSlice) error {
+		return exportErr
+	})
+	require.ErrorIs(t, err, exportErr)
+}
+
+func TestMemoryRepo_NearestGeolocations(t *testing.T) {
+	repo := NewMemoryRepo()
+	nearby, err := repo.NearestGeolocations(context.Background(), 0, 0, 10)
+	require.Nil(t, err)
+	require.Empty(t, nearby)
+
+	located := func(ip, city string, latitude, longitude float64) *model.Geolocation {
+		row := geolocation(ip, city)
+		row.Coordinates = pgeo.NewPoint(latitude, longitude)
+		return row
+	}
+	importSlices(t, repo, ImportOptions{Mode: ImportModeReplace, Promote: true}, geolocationSlice(
+		located("1.1.1.1", "Paris", 48.8566, 2.3522),
+		located("2.2.2.2", "London", 51.5074, -0.1278),
+		located("3.3.3.3", "Tokyo", 35.6762, 139.6503),
+		located("4.4.4.4", "Anadyr", 64.7337, 177.5089),
+	))
+	importSlices(t, repo, ImportOptions{Mode: ImportModeReplace}, geolocationSlice(
+		located("5.5.5.5", "Inactive", 48.8566, 2.3522),
+	))
+
+	nearby, err = repo.NearestGeolocations(context.Background(), 48.8566, 2.3522, 2)
+	require.Nil(t, err)
+	require.Len(t, nearby, 2)
+	require.Equal(t, "Paris", nearby[0].City.String)
+	require.Zero(t, nearby[0].DistanceKm)
+	require.Equal(t, "London", nearby[1].City.String)
+	require.InDelta(t, 343.5, nearby[1].DistanceKm, 1)
+
+	// great-circle distance wraps around antimeridian
+	nearby, err = repo.NearestGeolocations(context.Background(), 60, -170, 10)
+	require.Nil(t, err)
+	require.Len(t, nearby, 4)
+	require.Equal(t, "Anadyr", nearby[0].City.String)
+}
+
+func TestMemoryRepo_SearchGeolocations(t *testing.T) {
+	repo := NewMemoryRepo()
+	result, err := repo.SearchGeolocations(context.Background(), SearchOptions{})
+	require.Nil(t, err)
+	require.Empty(t, result.Geolocations)
+
+	located := func(ip, city, countryAlpha2 string) *model.Geolocation {
+		row := geolocation(ip, city)
+		row.CountryAlpha2 = null.NewString(countryAlpha2, len(countryAlpha2) != 0)
+		return row
+	}
+	importSlices(t, repo, ImportOptions{Mode: ImportModeReplace, Promote: true}, geolocationSlice(
+		located("2001:db8::/32", "PARIS", "FR"),
+		located("10.1.0.0/16", "paris", "FR"),
+		located("10.0.0.0/8", "Lyon", "FR"),
+		located("9.9.9.9", "Paris", ""),
+		located("10.1.2.3", "Berlin", "DE"),
+	))
+
+	search := func(options SearchOptions) []string {
+		ips := make([]string, 0)
+		for {
+			result, err := repo.SearchGeolocations(context.Background(), options)
+			require.Nil(t, err)
+			for _, location := range result.Geolocations {
+				ips = append(ips, location.IPAddress)
+			}
+			if len(result.NextCursor) == 0 {
+				return ips
+			}
+			options.Cursor = result.NextCursor
+		}
+	}
+	tests := []struct {
+		name     string
+		options  SearchOptions
+		expected []string
+	}{
+		{
+			name:     "all by IP address",
+			options:  SearchOptions{Limit: 2},
+			expected: []string{"9.9.9.9", "10.0.0.0/8", "10.1.0.0/16", "10.1.2.3", "2001:db8::/32"},
+		},
+		{
+			name:     "descending",
+			options:  SearchOptions{Descending: true, Limit: 3},
+			expected: []string{"2001:db8::/32", "10.1.2.3", "10.1.0.0/16", "10.0.0.0/8", "9.9.9.9"},
+		},
+		{
+			name:     "city case-insensitively",
+			options:  SearchOptions{City: "PARIS", Limit: 1},
+			expected: []string{"9.9.9.9", "10.1.0.0/16", "2001:db8::/32"},
+		},
+		{
+			name:     "reference country",
+			options:  SearchOptions{Country: "france", CountryCode: "fr", Limit: 1},
+			expected: []string{"10.0.0.0/8", "10.1.0.0/16", "2001:db8::/32"},
+		},
+		{
+			name:     "imported country",
+			options:  SearchOptions{Country: "Morocco", CountryCode: "RU"},
+			expected: []string{"9.9.9.9"},
+		},
+		{
+			name:     "IP prefix",
+			options:  SearchOptions{IPPrefix: "10.1.0.0/16"},
+			expected: []string{"10.1.0.0/16", "10.1.2.3"},
+		},
+		{
+			name:     "sort by city",
+			options:  SearchOptions{Sort: SearchSortCity, Limit: 2},
+			expected: []string{"10.1.2.3", "10.0.0.0/8", "2001:db8::/32", "9.9.9.9", "10.1.0.0/16"},
+		},
+		{
+			name:     "sort by country descending",
+			options:  SearchOptions{Sort: SearchSortCountry, Descending: true, IPPrefix: "10.1.0.0/16", Limit: 1},
+			expected: []string{"10.1.2.3", "10.1.0.0/16"},
+		},
+		{
+			name:     "created range",
+			options:  SearchOptions{CreatedFrom: time.Now().Add(time.Hour)},
+			expected: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, search(tt.options))
+		})
+	}
+
+	result, err = repo.SearchGeolocations(context.Background(), SearchOptions{Limit: 1})
+	require.Nil(t, err)
+	_, err = repo.SearchGeolocations(context.Background(), SearchOptions{Sort: SearchSortCity, Cursor: result.NextCursor})
+	require.ErrorIs(t, err, ErrInvalidCursor)
+	_, err = repo.SearchGeolocations(context.Background(), SearchOptions{Cursor: "invalid"})
+	require.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestMemoryRepo_Stats(t *testing.T) {
+	repo := NewMemoryRepo()
+	stats, err := repo.Stats(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, Stats{}, stats)
+
+	located := func(ip, city, countryAlpha2 string, latitude, longitude float64) *model.Geolocation {
+		row := geolocation(ip, city)
+		row.CountryAlpha2 = null.NewString(countryAlpha2, len(countryAlpha2) != 0)
+		row.Coordinates = pgeo.NewPoint(latitude, longitude)
+		return row
+	}
+	createdAt := time.Date(2022, 8, 11, 12, 0, 0, 0, time.UTC)
+	newest := located("4.4.4.4", "", "FR", 50, 3)
+	newest.CreatedAt = null.TimeFrom(createdAt)
+	importSlices(t, repo, ImportOptions{Mode: ImportModeReplace, Promote: true}, geolocationSlice(
+		located("1.1.1.1", "Paris", "FR", 48.8, 2.3),
+		located("2.2.2.2", "Paris", "FR", 48.9, 2.4),
+		located("3.3.3.3", "Lyon", "FR", 45.7, 4.8),
+		newest,
+		located("5.5.5.5", "Paris", "", -10, -20),
+	))
+
+	stats, err = repo.Stats(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 5, stats.Records)
+	require.Equal(t, 2, stats.Countries)
+	require.Equal(t, 3, stats.Cities)
+	require.True(t, stats.NewestCreatedAt.Time.After(createdAt))
+
+	countries, err := repo.CountryStats(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, []CountryStats{
+		{
+			Code:         "FR",
+			Name:         "France",
+			Records:      4,
+			Cities:       2,
+			MinLatitude:  45.7,
+			MinLongitude: 2.3,
+			MaxLatitude:  50,
+			MaxLongitude: 4.8,
+		},
+		{
+			Code:         "RU",
+			Name:         "Morocco",
+			Records:      1,
+			Cities:       1,
+			MinLatitude:  -10,
+			MinLongitude: -20,
+			MaxLatitude:  -10,
+			MaxLongitude: -20,
+		},
+	}, countries)
+
+	cities, err := repo.CityStats(context.Background(), "", 10)
+	require.Nil(t, err)
+	require.Equal(t, []CityStats{
+		{CountryCode: "FR", City: "Paris", Records: 2},
+		{CountryCode: "FR", City: "Lyon", Records: 1},
+		{CountryCode: "RU", City: "Paris", Records: 1},
+	}, cities)
+	cities, err = repo.CityStats(context.Background(), "ru", 1)
+	require.Nil(t, err)
+	require.Equal(t, []CityStats{{CountryCode: "RU", City: "Paris", Records: 1}}, cities)
+	cities, err = repo.CityStats(context.Background(), "", -1)
+	require.Nil(t, err)
+	require.Empty(t, cities)
+}
+
+func TestMemoryRepo_ImportHistory(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepo()
+	runs, err := repo.ListImports(ctx, 10)
+	require.Nil(t, err)
+	require.Empty(t, runs)
+
+	first, err := repo.StartImport(ctx, "first.csv")
+	require.Nil(t, err)
+	require.Equal(t, ImportStatusRunning, first.Status)
+	row := geolocation("1.1.1.1", "Paris")
+	row.ImportID = null.IntFrom(first.ID)
+	result := importSlices(t, repo, ImportOptions{Mode: ImportModeReplace, Promote: true}, geolocationSlice(row))
+	first.Status = ImportStatusSucceeded
+	first.Accepted = 1
+	first.DatasetID = null.IntFrom(result.DatasetID)
+	require.Nil(t, repo.FinishImport(ctx, first))
+
+	second, err := repo.StartImport(ctx, "second.csv")
+	require.Nil(t, err)
+	second.Status = ImportStatusFailed
+	second.DiscardedByReason = DiscardedByReason{"invalid_ip": 2}
+	second.Discarded = 2
+	second.Error = null.StringFrom("failed to import second.csv")
+	require.Nil(t, repo.FinishImport(ctx, second))
+
+	runs, err = repo.ListImports(ctx, 10)
+	require.Nil(t, err)
+	require.Len(t, runs, 2)
+	require.Equal(t, "second.csv", runs[0].Source)
+	require.Equal(t, ImportStatusFailed, runs[0].Status)
+	require.Equal(t, DiscardedByReason{"invalid_ip": 2}, runs[0].DiscardedByReason)
+	require.True(t, runs[0].FinishedAt.Valid)
+	require.Equal(t, first.ID, runs[1].ID)
+	require.Equal(t, null.IntFrom(result.DatasetID), runs[1].DatasetID)
+	require.Equal(t, DiscardedByReason{}, runs[1].DiscardedByReason)
+	runs, err = repo.ListImports(ctx, -1)
+	require.Nil(t, err)
+	require.Empty(t, runs)
+
+	location, err := repo.LocateIP(ctx, "1.1.1.1")
+	require.Nil(t, err)
+	require.Equal(t, null.IntFrom(first.ID), location.ImportID)
+
+	// pruned dataset is forgotten by import run
+	importSlices(t, repo, ImportOptions{Mode: ImportModeReplace, Promote: true, KeepDatasets: 1},
+		geolocationSlice(geolocation("2.2.2.2", "Lyon")))
+	runs, err = repo.ListImports(ctx, 1)
+	require.Nil(t, err)
+	require.Len(t, runs, 1)
+	runs, err = repo.ListImports(ctx, 10)
+	require.Nil(t, err)
+	require.False(t, runs[1].DatasetID.Valid)
+
+	require.NotNil(t, repo.FinishImport(ctx, ImportRun{ID: 3}))
+}
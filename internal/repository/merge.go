@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository/model"
+	"github.com/friendsofgo/errors"
+)
+
+const mergeStagingTable = "geolocations_merge"
+
+// mergeGeolocationBatches loads batches into temporary staging table and builds target dataset from it and rows of
+// the active dataset absent in batches (unless deleteMissing is set). The first occurrence of ip_address in batches
+// wins
+func mergeGeolocationBatches(
+	ctx context.Context,
+	tx *sql.Tx,
+	batches <-chan GeolocationSlice,
+	activeID int,
+	targetID int,
+	deleteMissing bool,
+) (ImportStats, error) {
+	stats := ImportStats{}
+	_, err := tx.ExecContext(ctx, fmt.Sprintf(
+		`create temp table %s (like %s including defaults) on commit drop`,
+		mergeStagingTable,
+		model.TableNames.Geolocations,
+	))
+	if err != nil {
+		return stats, errors.Wrap(err, "failed to create staging table")
+	}
+	_, err = copyGeolocationBatches(ctx, tx, mergeStagingTable, targetID, batches)
+	if err != nil {
+		return stats, err
+	}
+
+	// networks from different rows (e.g. overlapping ranges) could still collide, keep the first one
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(
+		`delete from %[1]s s using %[1]s d where s.ip_address = d.ip_address and s.id > d.id`,
+		mergeStagingTable,
+	))
+	if err != nil {
+		return stats, errors.Wrap(err, "failed to deduplicate staging table")
+	}
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(
+		`create index on %[1]s (ip_address); analyze %[1]s`,
+		mergeStagingTable,
+	))
+	if err != nil {
+		return stats, errors.Wrap(err, "failed to index staging table")
+	}
+
+	err = tx.QueryRowContext(ctx, fmt.Sprintf(
+		`select
+			count(*) filter (where g.id is null),
+			count(*) filter (where g.id is not null
+				and ((g.country_code, g.country, g.city, g.mystery_value, g.country_alpha2)
+					is distinct from (s.country_code, s.country, s.city, s.mystery_value, s.country_alpha2)
+					or not g.coordinates ~= s.coordinates)),
+			count(g.id)
+		from %[1]s s
+		left join %[2]s g on g.dataset_id = $1 and g.ip_address = s.ip_address`,
+		mergeStagingTable,
+		model.TableNames.Geolocations,
+	), activeID).Scan(&stats.Inserted, &stats.Updated, &stats.Unchanged)
+	if err != nil {
+		return stats, errors.Wrap(err, "failed to compare with active dataset")
+	}
+	stats.Unchanged -= stats.Updated
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(
+		`insert into %[2]s
+			(dataset_id, ip_address, country_code, country, city, coordinates, mystery_value, country_alpha2,
+			import_id)
+		select dataset_id, ip_address, country_code, country, city, coordinates, mystery_value, country_alpha2,
+			import_id
+		from %[1]s`,
+		mergeStagingTable,
+		model.TableNames.Geolocations,
+	))
+	if err != nil {
+		return stats, errors.Wrap(err, "failed to insert imported rows")
+	}
+
+	// rows of the active dataset absent in batches
+	missing := fmt.Sprintf(
+		`select ip_address, country_code, country, city, coordinates, mystery_value, created_at, country_alpha2,
+			import_id
+		from %[2]s g
+		where g.dataset_id = $1 and not exists(select from %[1]s s where s.ip_address = g.ip_address)`,
+		mergeStagingTable,
+		model.TableNames.Geolocations,
+	)
+	if deleteMissing {
+		err = tx.QueryRowContext(ctx, `select count(*) from (`+missing+`) missing`, activeID).Scan(&stats.Deleted)
+		if err != nil {
+			return stats, errors.Wrap(err, "failed to count missing rows")
+		}
+		return stats, nil
+	}
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(
+		`insert into %s
+			(dataset_id, ip_address, country_code, country, city, coordinates, mystery_value, created_at, country_alpha2,
+			import_id)
+		select $2, m.* from (%s) m`,
+		model.TableNames.Geolocations,
+		missing,
+	), activeID, targetID)
+	if err != nil {
+		return stats, errors.Wrap(err, "failed to copy missing rows")
+	}
+
+	return stats, nil
+}
+
+func execAffected(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) (int, error) {
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+
+	return int(affected), err
+}
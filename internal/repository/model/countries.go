@@ -0,0 +1,1314 @@
+// Code generated by SQLBoiler 4.12.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Country is an object representing the database table.
+type Country struct {
+	Code        string `boil:"code" json:"code" toml:"code" yaml:"code"`
+	Alpha3      string `boil:"alpha3" json:"alpha3" toml:"alpha3" yaml:"alpha3"`
+	NumericCode string `boil:"numeric_code" json:"numeric_code" toml:"numeric_code" yaml:"numeric_code"`
+	Name        string `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Continent   string `boil:"continent" json:"continent" toml:"continent" yaml:"continent"`
+
+	R *countryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L countryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CountryColumns = struct {
+	Code        string
+	Alpha3      string
+	NumericCode string
+	Name        string
+	Continent   string
+}{
+	Code:        "code",
+	Alpha3:      "alpha3",
+	NumericCode: "numeric_code",
+	Name:        "name",
+	Continent:   "continent",
+}
+
+var CountryTableColumns = struct {
+	Code        string
+	Alpha3      string
+	NumericCode string
+	Name        string
+	Continent   string
+}{
+	Code:        "countries.code",
+	Alpha3:      "countries.alpha3",
+	NumericCode: "countries.numeric_code",
+	Name:        "countries.name",
+	Continent:   "countries.continent",
+}
+
+// Generated where
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var CountryWhere = struct {
+	Code        whereHelperstring
+	Alpha3      whereHelperstring
+	NumericCode whereHelperstring
+	Name        whereHelperstring
+	Continent   whereHelperstring
+}{
+	Code:        whereHelperstring{field: "\"countries\".\"code\""},
+	Alpha3:      whereHelperstring{field: "\"countries\".\"alpha3\""},
+	NumericCode: whereHelperstring{field: "\"countries\".\"numeric_code\""},
+	Name:        whereHelperstring{field: "\"countries\".\"name\""},
+	Continent:   whereHelperstring{field: "\"countries\".\"continent\""},
+}
+
+// CountryRels is where relationship names are stored.
+var CountryRels = struct {
+	CountryAlpha2Geolocations string
+}{
+	CountryAlpha2Geolocations: "CountryAlpha2Geolocations",
+}
+
+// countryR is where relationships are stored.
+type countryR struct {
+	CountryAlpha2Geolocations GeolocationSlice `boil:"CountryAlpha2Geolocations" json:"CountryAlpha2Geolocations" toml:"CountryAlpha2Geolocations" yaml:"CountryAlpha2Geolocations"`
+}
+
+// NewStruct creates a new relationship struct
+func (*countryR) NewStruct() *countryR {
+	return &countryR{}
+}
+
+func (r *countryR) GetCountryAlpha2Geolocations() GeolocationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.CountryAlpha2Geolocations
+}
+
+// countryL is where Load methods for each relationship are stored.
+type countryL struct{}
+
+var (
+	countryAllColumns            = []string{"code", "alpha3", "numeric_code", "name", "continent"}
+	countryColumnsWithoutDefault = []string{"code", "alpha3", "numeric_code", "name", "continent"}
+	countryColumnsWithDefault    = []string{}
+	countryPrimaryKeyColumns     = []string{"code"}
+	countryGeneratedColumns      = []string{}
+)
+
+type (
+	// CountrySlice is an alias for a slice of pointers to Country.
+	// This should almost always be used instead of []Country.
+	CountrySlice []*Country
+	// CountryHook is the signature for custom Country hook methods
+	CountryHook func(context.Context, boil.ContextExecutor, *Country) error
+
+	countryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	countryType                 = reflect.TypeOf(&Country{})
+	countryMapping              = queries.MakeStructMapping(countryType)
+	countryPrimaryKeyMapping, _ = queries.BindMapping(countryType, countryMapping, countryPrimaryKeyColumns)
+	countryInsertCacheMut       sync.RWMutex
+	countryInsertCache          = make(map[string]insertCache)
+	countryUpdateCacheMut       sync.RWMutex
+	countryUpdateCache          = make(map[string]updateCache)
+	countryUpsertCacheMut       sync.RWMutex
+	countryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var countryAfterSelectHooks []CountryHook
+
+var countryBeforeInsertHooks []CountryHook
+var countryAfterInsertHooks []CountryHook
+
+var countryBeforeUpdateHooks []CountryHook
+var countryAfterUpdateHooks []CountryHook
+
+var countryBeforeDeleteHooks []CountryHook
+var countryAfterDeleteHooks []CountryHook
+
+var countryBeforeUpsertHooks []CountryHook
+var countryAfterUpsertHooks []CountryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Country) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range countryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Country) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range countryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Country) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range countryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Country) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range countryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Country) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range countryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Country) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range countryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Country) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range countryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Country) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range countryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Country) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range countryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCountryHook registers your hook function for all future operations.
+func AddCountryHook(hookPoint boil.HookPoint, countryHook CountryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		countryAfterSelectHooks = append(countryAfterSelectHooks, countryHook)
+	case boil.BeforeInsertHook:
+		countryBeforeInsertHooks = append(countryBeforeInsertHooks, countryHook)
+	case boil.AfterInsertHook:
+		countryAfterInsertHooks = append(countryAfterInsertHooks, countryHook)
+	case boil.BeforeUpdateHook:
+		countryBeforeUpdateHooks = append(countryBeforeUpdateHooks, countryHook)
+	case boil.AfterUpdateHook:
+		countryAfterUpdateHooks = append(countryAfterUpdateHooks, countryHook)
+	case boil.BeforeDeleteHook:
+		countryBeforeDeleteHooks = append(countryBeforeDeleteHooks, countryHook)
+	case boil.AfterDeleteHook:
+		countryAfterDeleteHooks = append(countryAfterDeleteHooks, countryHook)
+	case boil.BeforeUpsertHook:
+		countryBeforeUpsertHooks = append(countryBeforeUpsertHooks, countryHook)
+	case boil.AfterUpsertHook:
+		countryAfterUpsertHooks = append(countryAfterUpsertHooks, countryHook)
+	}
+}
+
+// OneG returns a single country record from the query using the global executor.
+func (q countryQuery) OneG(ctx context.Context) (*Country, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single country record from the query.
+func (q countryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Country, error) {
+	o := &Country{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: failed to execute a one query for countries")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all Country records from the query using the global executor.
+func (q countryQuery) AllG(ctx context.Context) (CountrySlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all Country records from the query.
+func (q countryQuery) All(ctx context.Context, exec boil.ContextExecutor) (CountrySlice, error) {
+	var o []*Country
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "model: failed to assign all query results to Country slice")
+	}
+
+	if len(countryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Country records in the query using the global executor
+func (q countryQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all Country records in the query.
+func (q countryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to count countries rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q countryQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q countryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "model: failed to check if countries exists")
+	}
+
+	return count > 0, nil
+}
+
+// CountryAlpha2Geolocations retrieves all the geolocation's Geolocations with an executor via country_alpha2 column.
+func (o *Country) CountryAlpha2Geolocations(mods ...qm.QueryMod) geolocationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"geolocations\".\"country_alpha2\"=?", o.Code),
+	)
+
+	return Geolocations(queryMods...)
+}
+
+// LoadCountryAlpha2Geolocations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (countryL) LoadCountryAlpha2Geolocations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCountry interface{}, mods queries.Applicator) error {
+	var slice []*Country
+	var object *Country
+
+	if singular {
+		var ok bool
+		object, ok = maybeCountry.(*Country)
+		if !ok {
+			object = new(Country)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCountry)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCountry))
+			}
+		}
+	} else {
+		s, ok := maybeCountry.(*[]*Country)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCountry)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCountry))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &countryR{}
+		}
+		args = append(args, object.Code)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &countryR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.Code) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.Code)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`geolocations`),
+		qm.WhereIn(`geolocations.country_alpha2 in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load geolocations")
+	}
+
+	var resultSlice []*Geolocation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice geolocations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on geolocations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for geolocations")
+	}
+
+	if len(geolocationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CountryAlpha2Geolocations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &geolocationR{}
+			}
+			foreign.R.CountryAlpha2Country = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.Code, foreign.CountryAlpha2) {
+				local.R.CountryAlpha2Geolocations = append(local.R.CountryAlpha2Geolocations, foreign)
+				if foreign.R == nil {
+					foreign.R = &geolocationR{}
+				}
+				foreign.R.CountryAlpha2Country = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddCountryAlpha2GeolocationsG adds the given related objects to the existing relationships
+// of the country, optionally inserting them as new records.
+// Appends related to o.R.CountryAlpha2Geolocations.
+// Sets related.R.CountryAlpha2Country appropriately.
+// Uses the global database handle.
+func (o *Country) AddCountryAlpha2GeolocationsG(ctx context.Context, insert bool, related ...*Geolocation) error {
+	return o.AddCountryAlpha2Geolocations(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddCountryAlpha2Geolocations adds the given related objects to the existing relationships
+// of the country, optionally inserting them as new records.
+// Appends related to o.R.CountryAlpha2Geolocations.
+// Sets related.R.CountryAlpha2Country appropriately.
+func (o *Country) AddCountryAlpha2Geolocations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Geolocation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CountryAlpha2, o.Code)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"geolocations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"country_alpha2"}),
+				strmangle.WhereClause("\"", "\"", 2, geolocationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.Code, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CountryAlpha2, o.Code)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &countryR{
+			CountryAlpha2Geolocations: related,
+		}
+	} else {
+		o.R.CountryAlpha2Geolocations = append(o.R.CountryAlpha2Geolocations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &geolocationR{
+				CountryAlpha2Country: o,
+			}
+		} else {
+			rel.R.CountryAlpha2Country = o
+		}
+	}
+	return nil
+}
+
+// SetCountryAlpha2GeolocationsG removes all previously related items of the
+// country replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CountryAlpha2Country's CountryAlpha2Geolocations accordingly.
+// Replaces o.R.CountryAlpha2Geolocations with related.
+// Sets related.R.CountryAlpha2Country's CountryAlpha2Geolocations accordingly.
+// Uses the global database handle.
+func (o *Country) SetCountryAlpha2GeolocationsG(ctx context.Context, insert bool, related ...*Geolocation) error {
+	return o.SetCountryAlpha2Geolocations(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetCountryAlpha2Geolocations removes all previously related items of the
+// country replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CountryAlpha2Country's CountryAlpha2Geolocations accordingly.
+// Replaces o.R.CountryAlpha2Geolocations with related.
+// Sets related.R.CountryAlpha2Country's CountryAlpha2Geolocations accordingly.
+func (o *Country) SetCountryAlpha2Geolocations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Geolocation) error {
+	query := "update \"geolocations\" set \"country_alpha2\" = null where \"country_alpha2\" = $1"
+	values := []interface{}{o.Code}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CountryAlpha2Geolocations {
+			queries.SetScanner(&rel.CountryAlpha2, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CountryAlpha2Country = nil
+		}
+		o.R.CountryAlpha2Geolocations = nil
+	}
+
+	return o.AddCountryAlpha2Geolocations(ctx, exec, insert, related...)
+}
+
+// RemoveCountryAlpha2GeolocationsG relationships from objects passed in.
+// Removes related items from R.CountryAlpha2Geolocations (uses pointer comparison, removal does not keep order)
+// Sets related.R.CountryAlpha2Country.
+// Uses the global database handle.
+func (o *Country) RemoveCountryAlpha2GeolocationsG(ctx context.Context, related ...*Geolocation) error {
+	return o.RemoveCountryAlpha2Geolocations(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveCountryAlpha2Geolocations relationships from objects passed in.
+// Removes related items from R.CountryAlpha2Geolocations (uses pointer comparison, removal does not keep order)
+// Sets related.R.CountryAlpha2Country.
+func (o *Country) RemoveCountryAlpha2Geolocations(ctx context.Context, exec boil.ContextExecutor, related ...*Geolocation) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CountryAlpha2, nil)
+		if rel.R != nil {
+			rel.R.CountryAlpha2Country = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("country_alpha2")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CountryAlpha2Geolocations {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CountryAlpha2Geolocations)
+			if ln > 1 && i < ln-1 {
+				o.R.CountryAlpha2Geolocations[i] = o.R.CountryAlpha2Geolocations[ln-1]
+			}
+			o.R.CountryAlpha2Geolocations = o.R.CountryAlpha2Geolocations[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// Countries retrieves all the records using an executor.
+func Countries(mods ...qm.QueryMod) countryQuery {
+	mods = append(mods, qm.From("\"countries\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"countries\".*"})
+	}
+
+	return countryQuery{q}
+}
+
+// FindCountryG retrieves a single record by ID.
+func FindCountryG(ctx context.Context, code string, selectCols ...string) (*Country, error) {
+	return FindCountry(ctx, boil.GetContextDB(), code, selectCols...)
+}
+
+// FindCountry retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCountry(ctx context.Context, exec boil.ContextExecutor, code string, selectCols ...string) (*Country, error) {
+	countryObj := &Country{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"countries\" where \"code\"=$1", sel,
+	)
+
+	q := queries.Raw(query, code)
+
+	err := q.Bind(ctx, exec, countryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: unable to select from countries")
+	}
+
+	if err = countryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return countryObj, err
+	}
+
+	return countryObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Country) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Country) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no countries provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(countryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	countryInsertCacheMut.RLock()
+	cache, cached := countryInsertCache[key]
+	countryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			countryAllColumns,
+			countryColumnsWithDefault,
+			countryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(countryType, countryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(countryType, countryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"countries\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"countries\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to insert into countries")
+	}
+
+	if !cached {
+		countryInsertCacheMut.Lock()
+		countryInsertCache[key] = cache
+		countryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single Country record using the global executor.
+// See Update for more documentation.
+func (o *Country) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the Country.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Country) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	countryUpdateCacheMut.RLock()
+	cache, cached := countryUpdateCache[key]
+	countryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			countryAllColumns,
+			countryPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("model: unable to update countries, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"countries\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, countryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(countryType, countryMapping, append(wl, countryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to update countries row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by update for countries")
+	}
+
+	if !cached {
+		countryUpdateCacheMut.Lock()
+		countryUpdateCache[key] = cache
+		countryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q countryQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q countryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to update all for countries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to retrieve rows affected for countries")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o CountrySlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CountrySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("model: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), countryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"countries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, countryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to update all in country slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to retrieve rows affected all in update all country")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Country) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Country) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no countries provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(countryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	countryUpsertCacheMut.RLock()
+	cache, cached := countryUpsertCache[key]
+	countryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			countryAllColumns,
+			countryColumnsWithDefault,
+			countryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			countryAllColumns,
+			countryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("model: unable to upsert countries, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(countryPrimaryKeyColumns))
+			copy(conflict, countryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"countries\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(countryType, countryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(countryType, countryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "model: unable to upsert countries")
+	}
+
+	if !cached {
+		countryUpsertCacheMut.Lock()
+		countryUpsertCache[key] = cache
+		countryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single Country record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Country) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single Country record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Country) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("model: no Country provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), countryPrimaryKeyMapping)
+	sql := "DELETE FROM \"countries\" WHERE \"code\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to delete from countries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by delete for countries")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q countryQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q countryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("model: no countryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to delete all from countries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by deleteall for countries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o CountrySlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CountrySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(countryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), countryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"countries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, countryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to delete all from country slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by deleteall for countries")
+	}
+
+	if len(countryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Country) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("model: no Country provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Country) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCountry(ctx, exec, o.Code)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CountrySlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("model: empty CountrySlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CountrySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CountrySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), countryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"countries\".* FROM \"countries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, countryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to reload all in CountrySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CountryExistsG checks if the Country row exists.
+func CountryExistsG(ctx context.Context, code string) (bool, error) {
+	return CountryExists(ctx, boil.GetContextDB(), code)
+}
+
+// CountryExists checks if the Country row exists.
+func CountryExists(ctx context.Context, exec boil.ContextExecutor, code string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"countries\" where \"code\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, code)
+	}
+	row := exec.QueryRowContext(ctx, sql, code)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "model: unable to check if countries exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository/model"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/types/pgeo"
+)
+
+const (
+	earthRadiusKm = 6371.0088
+	// nearestCandidatesFactor is how many times more rows than requested are preselected by planar distance in
+	// degrees, which is index-backed but distorted away from the equator, before they are ranked by great-circle
+	// distance
+	nearestCandidatesFactor = 4
+)
+
+// NearbyGeolocation is geolocation with distance to requested coordinates
+type NearbyGeolocation struct {
+	Geolocation
+	DistanceKm float64
+}
+
+// nearestGeolocations preselects rows of the active dataset with KNN query on coordinates gist index and returns the
+// closest ones by great-circle distance
+func nearestGeolocations(
+	ctx context.Context,
+	conn *sql.DB,
+	latitude float64,
+	longitude float64,
+	limit int,
+) ([]NearbyGeolocation, error) {
+	if limit < 1 {
+		return nil, nil
+	}
+	var candidates []struct {
+		model.Geolocation `boil:",bind"`
+		CountryRef        countryColumns `boil:",bind"`
+	}
+	err := queries.Raw(fmt.Sprintf(
+		`select g.*, %[1]s
+		from (
+			select * from %[2]s
+			where %[3]s
+			order by %[4]s <-> point($1::float8, $2::float8)
+			limit $3
+		) g
+		left join %[5]s c on c.code = g.%[6]s`,
+		countryColumnsSelect,
+		model.TableNames.Geolocations,
+		activeDatasetCondition,
+		model.GeolocationColumns.Coordinates,
+		countriesTable,
+		model.GeolocationColumns.CountryAlpha2,
+	), latitude, longitude, limit*nearestCandidatesFactor).Bind(ctx, conn, &candidates)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get nearest geo locations from db")
+	}
+
+	nearby := make([]NearbyGeolocation, 0, len(candidates))
+	for _, candidate := range candidates {
+		nearby = append(nearby, NearbyGeolocation{
+			Geolocation: Geolocation{
+				Geolocation: candidate.Geolocation,
+				CountryRef:  candidate.CountryRef.country(candidate.CountryAlpha2),
+			},
+			DistanceKm: distanceKm(latitude, longitude, candidate.Coordinates),
+		})
+	}
+
+	return rankNearby(nearby, limit), nil
+}
+
+// rankNearby sorts geolocations by distance, ties are sorted by IP address, and keeps the closest limit ones
+func rankNearby(nearby []NearbyGeolocation, limit int) []NearbyGeolocation {
+	sort.Slice(nearby, func(i, j int) bool {
+		if nearby[i].DistanceKm != nearby[j].DistanceKm {
+			return nearby[i].DistanceKm < nearby[j].DistanceKm
+		}
+		return nearby[i].IPAddress < nearby[j].IPAddress
+	})
+	if len(nearby) > limit {
+		nearby = nearby[:limit]
+	}
+
+	return nearby
+}
+
+// distanceKm returns great-circle distance between coordinates and point(latitude, longitude) by haversine formula
+func distanceKm(latitude, longitude float64, point pgeo.Point) float64 {
+	toRadians := math.Pi / 180
+	latitudeDelta := (point.X - latitude) * toRadians
+	longitudeDelta := (point.Y - longitude) * toRadians
+	a := math.Pow(math.Sin(latitudeDelta/2), 2) +
+		math.Cos(latitude*toRadians)*math.Cos(point.X*toRadians)*math.Pow(math.Sin(longitudeDelta/2), 2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
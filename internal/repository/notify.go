@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/lib/pq"
+)
+
+// datasetsChannel is postgres notification channel which receives id of every promoted dataset
+const datasetsChannel = "datasets_promoted"
+
+const (
+	listenerMinReconnectInterval = time.Second
+	listenerMaxReconnectInterval = time.Minute
+)
+
+// ListenDatasetPromotions calls onPromote whenever dataset is promoted by any process using db, until ctx is done.
+// onPromote is called on reconnect too, as notifications sent while connection was lost are missed
+func ListenDatasetPromotions(ctx context.Context, psqlURL string, onPromote func()) error {
+	listener := pq.NewListener(psqlURL, listenerMinReconnectInterval, listenerMaxReconnectInterval, nil)
+	defer listener.Close()
+	err := listener.Listen(datasetsChannel)
+	if err != nil {
+		return errors.Wrap(err, "failed to listen for promoted datasets")
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-listener.Notify:
+			// nil notification means that connection was re-established
+			onPromote()
+		}
+	}
+}
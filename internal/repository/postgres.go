@@ -3,12 +3,13 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/MaximChernomorov/challenge-test/internal/repository/model"
 	"github.com/friendsofgo/errors"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/volatiletech/sqlboiler/v4/boil"
-	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries"
 )
 
 type PostgresRepo struct {
@@ -28,18 +29,164 @@ func NewPostgresRepo(psqlURL string) (Repository, error) {
 	return repo, nil
 }
 
+// AddGeolocationSlice stores geolocation slice as new active dataset which also contains rows of the previous one
 func (repo *PostgresRepo) AddGeolocationSlice(ctx context.Context, geolocationSlice GeolocationSlice) error {
-	return geolocationSlice.insertAll(ctx, repo.conn)
+	batches := make(chan GeolocationSlice, 1)
+	batches <- geolocationSlice
+	close(batches)
+	_, err := repo.ImportGeolocationBatches(ctx, batches, ImportOptions{Mode: ImportModeAppend, Promote: true})
+
+	return err
+}
+
+func (repo *PostgresRepo) ImportGeolocationBatches(
+	ctx context.Context,
+	batches <-chan GeolocationSlice,
+	options ImportOptions,
+) (ImportResult, error) {
+	var result ImportResult
+	err := inTx(ctx, repo.conn, func(tx *sql.Tx) error {
+		var err error
+		result, err = importGeolocationBatches(ctx, tx, batches, options)
+		return err
+	})
+	if err != nil || options.KeepDatasets == 0 {
+		return result, err
+	}
+
+	err = inTx(ctx, repo.conn, func(tx *sql.Tx) error {
+		var err error
+		result.Pruned, err = pruneDatasets(ctx, tx, options.KeepDatasets)
+		return err
+	})
+
+	return result, err
 }
 
+// LocateIP finds the most specific network containing IP in the active dataset, single IP rows are /32 or /128
+// networks
 func (repo *PostgresRepo) LocateIP(ctx context.Context, IP string) (Geolocation, error) {
-	location := Geolocation{}
-	locationDB, err := model.Geolocations(qm.Where(model.GeolocationColumns.IPAddress+"=?", IP)).OneG(ctx)
+	locations, err := repo.LocateIPs(ctx, []string{IP})
 	if err != nil {
-		return location, errors.Wrap(err, "failed to get geo location from db")
+		return Geolocation{}, err
+	}
+	location, found := locations[IP]
+	if !found {
+		return location, ErrLocationNotFound
+	}
+
+	return location, nil
+}
+
+// LocateIPs finds the most specific networks containing IPs in the active dataset with single query, countries
+// are joined to them
+func (repo *PostgresRepo) LocateIPs(ctx context.Context, IPs []string) (map[string]Geolocation, error) {
+	var located []struct {
+		RequestedIP       string `boil:"requested_ip"`
+		model.Geolocation `boil:",bind"`
+		CountryRef        countryColumns `boil:",bind"`
 	}
+	err := queries.Raw(fmt.Sprintf(
+		`select q.ip as requested_ip, g.*, %[4]s
+		from unnest($1::text[]) q(ip)
+		cross join lateral (
+			select * from %[1]s
+			where %[2]s and %[3]s >>= q.ip::inet
+			order by masklen(%[3]s) desc
+			limit 1
+		) g
+		left join %[5]s c on c.code = g.%[6]s`,
+		model.TableNames.Geolocations,
+		activeDatasetCondition,
+		model.GeolocationColumns.IPAddress,
+		countryColumnsSelect,
+		countriesTable,
+		model.GeolocationColumns.CountryAlpha2,
+	), pq.Array(IPs)).Bind(ctx, repo.conn, &located)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get geo locations from db")
+	}
+
+	locations := make(map[string]Geolocation, len(located))
+	for _, location := range located {
+		locations[location.RequestedIP] = Geolocation{
+			Geolocation: location.Geolocation,
+			CountryRef:  location.CountryRef.country(location.CountryAlpha2),
+		}
+	}
+
+	return locations, nil
+}
+
+func (repo *PostgresRepo) NearestGeolocations(
+	ctx context.Context,
+	latitude float64,
+	longitude float64,
+	limit int,
+) ([]NearbyGeolocation, error) {
+	return nearestGeolocations(ctx, repo.conn, latitude, longitude, limit)
+}
+
+func (repo *PostgresRepo) SearchGeolocations(ctx context.Context, options SearchOptions) (SearchResult, error) {
+	return searchGeolocations(ctx, repo.conn, options)
+}
+
+func (repo *PostgresRepo) ExportGeolocations(
+	ctx context.Context,
+	options ExportOptions,
+	fn func(GeolocationSlice) error,
+) error {
+	return exportGeolocations(ctx, repo.conn, options, fn)
+}
+
+func (repo *PostgresRepo) Stats(ctx context.Context) (Stats, error) {
+	return stats(ctx, repo.conn)
+}
+
+func (repo *PostgresRepo) CountryStats(ctx context.Context) ([]CountryStats, error) {
+	return countryStats(ctx, repo.conn)
+}
+
+func (repo *PostgresRepo) CityStats(ctx context.Context, countryCode string, limit int) ([]CityStats, error) {
+	return cityStats(ctx, repo.conn, countryCode, limit)
+}
+
+func (repo *PostgresRepo) ListDatasets(ctx context.Context) ([]Dataset, error) {
+	return listDatasets(ctx, repo.conn)
+}
+
+func (repo *PostgresRepo) PromoteDataset(ctx context.Context, id int) error {
+	return inTx(ctx, repo.conn, func(tx *sql.Tx) error {
+		return promoteDataset(ctx, tx, id)
+	})
+}
+
+func (repo *PostgresRepo) RollbackDataset(ctx context.Context) (int, error) {
+	var id int
+	err := inTx(ctx, repo.conn, func(tx *sql.Tx) error {
+		var err error
+		id, err = rollbackDataset(ctx, tx)
+		return err
+	})
+
+	return id, err
+}
+
+func (repo *PostgresRepo) StartImport(ctx context.Context, source string) (ImportRun, error) {
+	return startImport(ctx, repo.conn, source)
+}
+
+func (repo *PostgresRepo) FinishImport(ctx context.Context, run ImportRun) error {
+	return finishImport(ctx, repo.conn, run)
+}
+
+func (repo *PostgresRepo) ListImports(ctx context.Context, limit int) ([]ImportRun, error) {
+	return listImports(ctx, repo.conn, limit)
+}
 
-	return Geolocation{*locationDB}, nil
+// DB returns connection pool, e.g. to export its stats
+func (repo *PostgresRepo) DB() *sql.DB {
+	return repo.conn
 }
 
 func (repo *PostgresRepo) Close() error {
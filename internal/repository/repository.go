@@ -1,13 +1,61 @@
 package repository
 
-import "context"
+import (
+	"context"
+
+	"github.com/friendsofgo/errors"
+)
+
+// ErrLocationNotFound is returned when no network of the active dataset contains IP address
+var ErrLocationNotFound = errors.New("location not found")
 
 // Repository hides particular db implementation from client code
 type Repository interface {
 	// AddGeolocationSlice stores geolocation slice into db
 	AddGeolocationSlice(ctx context.Context, geolocationSlice GeolocationSlice) error
-	// LocateIP finds IP address in db and returns geolocation
+	// ImportGeolocationBatches stores batches into new dataset as they arrive until channel is closed. Nothing is
+	// stored if ctx is cancelled before that
+	ImportGeolocationBatches(
+		ctx context.Context,
+		batches <-chan GeolocationSlice,
+		options ImportOptions,
+	) (ImportResult, error)
+	// LocateIP finds the most specific network containing IP address in the active dataset and returns geolocation
 	LocateIP(ctx context.Context, IP string) (Geolocation, error)
+	// LocateIPs finds geolocations of all valid IP addresses at once, result is keyed by IP address as it is
+	// passed. IP addresses which are not found are absent in result
+	LocateIPs(ctx context.Context, IPs []string) (map[string]Geolocation, error)
+	// NearestGeolocations returns up to limit rows of the active dataset closest to coordinates, the closest first
+	NearestGeolocations(ctx context.Context, latitude, longitude float64, limit int) ([]NearbyGeolocation, error)
+	// SearchGeolocations returns page of rows of the active dataset matching options filters, pages are selected
+	// by cursor, so they don't shift when rows are imported meanwhile
+	SearchGeolocations(ctx context.Context, options SearchOptions) (SearchResult, error)
+	// ExportGeolocations passes rows of the active dataset matching options filters to fn in batches, all batches
+	// belong to the same dataset even if another one is promoted meanwhile. Export stops on the first fn error
+	ExportGeolocations(ctx context.Context, options ExportOptions, fn func(GeolocationSlice) error) error
+
+	// Stats summarizes the active dataset
+	Stats(ctx context.Context) (Stats, error)
+	// CountryStats summarizes the active dataset by country, countries with the most rows first
+	CountryStats(ctx context.Context) ([]CountryStats, error)
+	// CityStats returns up to limit cities of the active dataset with the most rows, only cities of country if
+	// country code isn't empty
+	CityStats(ctx context.Context, countryCode string, limit int) ([]CityStats, error)
+
+	// ListDatasets returns all datasets, the newest first
+	ListDatasets(ctx context.Context) ([]Dataset, error)
+	// PromoteDataset atomically switches readers to dataset
+	PromoteDataset(ctx context.Context, id int) error
+	// RollbackDataset promotes the newest dataset created before the active one and returns its id, datasets which were
+	// never promoted are skipped
+	RollbackDataset(ctx context.Context) (int, error)
+
+	// StartImport records import run of source as running, rows it writes refer to it by ImportID
+	StartImport(ctx context.Context, source string) (ImportRun, error)
+	// FinishImport records result of import run started by StartImport
+	FinishImport(ctx context.Context, run ImportRun) error
+	// ListImports returns up to limit recorded import runs, the newest first
+	ListImports(ctx context.Context, limit int) ([]ImportRun, error)
 
 	// Close db
 	Close() error
@@ -0,0 +1,386 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository/model"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+const defaultSearchLimit = 50
+
+// ErrInvalidCursor is returned when search cursor is malformed or it was issued for another sort
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// SearchSort is a field search results are sorted by, ties are sorted by row id, so pages are stable
+type SearchSort string
+
+const (
+	SearchSortIP        SearchSort = "ip"
+	SearchSortCity      SearchSort = "city"
+	SearchSortCountry   SearchSort = "country"
+	SearchSortCreatedAt SearchSort = "created_at"
+)
+
+// SearchSorts returns all search sorts, the default one first
+func SearchSorts() []SearchSort {
+	return []SearchSort{SearchSortIP, SearchSortCity, SearchSortCountry, SearchSortCreatedAt}
+}
+
+// ParseSearchSort parses search sort name, name prefixed with "-" means descending order. Empty name is the default
+// ascending sort by IP address
+func ParseSearchSort(name string) (SearchSort, bool, error) {
+	descending := strings.HasPrefix(name, "-")
+	name = strings.TrimPrefix(name, "-")
+	if len(name) == 0 {
+		return SearchSortIP, descending, nil
+	}
+	for _, sort := range SearchSorts() {
+		if string(sort) == name {
+			return sort, descending, nil
+		}
+	}
+
+	return "", false, errors.Errorf("unknown sort %q", name)
+}
+
+// SearchOptions filters rows of the active dataset and selects page of them. Country and country code are matched
+// case-insensitively against values of the countries reference table if row refers to a country and against
+// imported ones otherwise, city is matched case-insensitively. Empty filters are ignored
+type SearchOptions struct {
+	Country     string
+	CountryCode string
+	City        string
+	// IPPrefix is IP address or CIDR network, rows with networks inside of it are matched
+	IPPrefix string
+	// CreatedFrom is inclusive lower bound of row creation time
+	CreatedFrom time.Time
+	// CreatedTo is exclusive upper bound of row creation time
+	CreatedTo  time.Time
+	Sort       SearchSort
+	Descending bool
+	// Cursor is SearchResult.NextCursor of the previous page, empty for the first page
+	Cursor string
+	// Limit is max count of rows in page
+	Limit int
+}
+
+// SearchResult is page of search results
+type SearchResult struct {
+	Geolocations []Geolocation
+	// NextCursor continues search with the same options, empty on the last page
+	NextCursor string
+}
+
+func (options SearchOptions) limit() int {
+	if options.Limit < 1 {
+		return defaultSearchLimit
+	}
+
+	return options.Limit
+}
+
+func (options SearchOptions) sort() SearchSort {
+	if len(options.Sort) == 0 {
+		return SearchSortIP
+	}
+
+	return options.Sort
+}
+
+// prefixNetwork returns IP prefix in CIDR notation, empty if there is no IP prefix filter
+func (options SearchOptions) prefixNetwork() (string, error) {
+	if len(options.IPPrefix) == 0 {
+		return "", nil
+	}
+
+	return networkKey(options.IPPrefix)
+}
+
+// searchCursor points to the last row of page by its sort value and id
+type searchCursor struct {
+	Sort       SearchSort `json:"s"`
+	Descending bool       `json:"d,omitempty"`
+	Value      string     `json:"v"`
+	ID         int        `json:"i"`
+}
+
+// cursor decodes options cursor, it is nil for the first page
+func (options SearchOptions) cursor() (*searchCursor, error) {
+	if len(options.Cursor) == 0 {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(options.Cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	cursor := &searchCursor{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	err = decoder.Decode(cursor)
+	if err != nil || cursor.Sort != options.sort() || cursor.Descending != options.Descending {
+		return nil, ErrInvalidCursor
+	}
+	if _, err = parseSearchValue(cursor.Sort, cursor.Value); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return cursor, nil
+}
+
+// nextCursor encodes cursor pointing to location which is the last one of page
+func (options SearchOptions) nextCursor(location Geolocation) string {
+	data, _ := json.Marshal(searchCursor{
+		Sort:       options.sort(),
+		Descending: options.Descending,
+		Value:      searchValue(options.sort(), location),
+		ID:         location.ID,
+	})
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// page truncates search results sorted by options to options limit and sets next cursor if there are more of them
+func (options SearchOptions) page(locations []Geolocation) SearchResult {
+	result := SearchResult{Geolocations: locations}
+	if len(locations) > options.limit() {
+		result.Geolocations = locations[:options.limit()]
+		result.NextCursor = options.nextCursor(result.Geolocations[len(result.Geolocations)-1])
+	}
+
+	return result
+}
+
+// countryName is country name as returned to clients
+func countryName(location Geolocation) string {
+	if location.CountryRef != nil {
+		return location.CountryRef.Name
+	}
+
+	return location.Country.String
+}
+
+// countryCode is country code as returned to clients
+func countryCode(location Geolocation) string {
+	if location.CountryRef != nil {
+		return location.CountryRef.Code
+	}
+
+	return location.CountryCode.String
+}
+
+// searchValue is location value of sort field, null values are the same as empty ones
+func searchValue(sort SearchSort, location Geolocation) string {
+	switch sort {
+	case SearchSortCity:
+		return location.City.String
+	case SearchSortCountry:
+		return countryName(location)
+	case SearchSortCreatedAt:
+		return location.CreatedAt.Time.UTC().Format(time.RFC3339Nano)
+	default:
+		return location.IPAddress
+	}
+}
+
+// parseSearchValue checks sort value of cursor, it is parsed into comparable form for in-memory search
+func parseSearchValue(sort SearchSort, value string) (interface{}, error) {
+	switch sort {
+	case SearchSortCity, SearchSortCountry:
+		return value, nil
+	case SearchSortCreatedAt:
+		return time.Parse(time.RFC3339Nano, value)
+	default:
+		return parseNetwork(value)
+	}
+}
+
+// searchSortExpressions are sql expressions of sort fields with casts of cursor values, text is compared bytewise
+// and null values are the same as empty ones as in searchValue
+var searchSortExpressions = map[SearchSort][2]string{
+	SearchSortIP:   {model.GeolocationTableColumns.IPAddress, "inet"},
+	SearchSortCity: {fmt.Sprintf(`coalesce(%s, '') collate "C"`, model.GeolocationTableColumns.City), "text"},
+	SearchSortCountry: {
+		fmt.Sprintf(`coalesce(c.name, %s, '') collate "C"`, model.GeolocationTableColumns.Country),
+		"text",
+	},
+	SearchSortCreatedAt: {
+		fmt.Sprintf(`coalesce(%s, '0001-01-01T00:00:00Z')`, model.GeolocationTableColumns.CreatedAt),
+		"timestamptz",
+	},
+}
+
+// searchGeolocations selects page of rows of the active dataset with keyset pagination, one extra row is selected to
+// find out if there is the next page
+func searchGeolocations(ctx context.Context, conn *sql.DB, options SearchOptions) (SearchResult, error) {
+	cursor, err := options.cursor()
+	if err != nil {
+		return SearchResult{}, err
+	}
+	prefix, err := options.prefixNetwork()
+	if err != nil {
+		return SearchResult{}, err
+	}
+
+	var args []interface{}
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	conditions := []string{activeDatasetCondition}
+	if len(options.Country) != 0 {
+		conditions = append(conditions, fmt.Sprintf("lower(coalesce(c.name, %s)) = lower(%s)",
+			model.GeolocationTableColumns.Country, arg(options.Country)))
+	}
+	if len(options.CountryCode) != 0 {
+		conditions = append(conditions, fmt.Sprintf("upper(coalesce(%s, %s)) = upper(%s)",
+			model.GeolocationTableColumns.CountryAlpha2, model.GeolocationTableColumns.CountryCode,
+			arg(options.CountryCode)))
+	}
+	if len(options.City) != 0 {
+		conditions = append(conditions, fmt.Sprintf("lower(%s) = lower(%s)",
+			model.GeolocationTableColumns.City, arg(options.City)))
+	}
+	if len(prefix) != 0 {
+		conditions = append(conditions, fmt.Sprintf("%s <<= %s::inet",
+			model.GeolocationTableColumns.IPAddress, arg(prefix)))
+	}
+	if !options.CreatedFrom.IsZero() {
+		conditions = append(conditions, fmt.Sprintf("%s >= %s",
+			model.GeolocationTableColumns.CreatedAt, arg(options.CreatedFrom)))
+	}
+	if !options.CreatedTo.IsZero() {
+		conditions = append(conditions, fmt.Sprintf("%s < %s",
+			model.GeolocationTableColumns.CreatedAt, arg(options.CreatedTo)))
+	}
+	sortExpression := searchSortExpressions[options.sort()]
+	operator, direction := ">", "asc"
+	if options.Descending {
+		operator, direction = "<", "desc"
+	}
+	if cursor != nil {
+		conditions = append(conditions, fmt.Sprintf("(%s, %s) %s (%s::%s, %s)",
+			sortExpression[0], model.GeolocationTableColumns.ID, operator,
+			arg(cursor.Value), sortExpression[1], arg(cursor.ID)))
+	}
+
+	var found []struct {
+		model.Geolocation `boil:",bind"`
+		CountryRef        countryColumns `boil:",bind"`
+	}
+	err = queries.Raw(fmt.Sprintf(
+		`select %[1]s.*, %[2]s
+		from %[1]s
+		left join %[3]s c on c.code = %[4]s
+		where %[5]s
+		order by %[6]s %[7]s, %[8]s %[7]s
+		limit %[9]s`,
+		model.TableNames.Geolocations,
+		countryColumnsSelect,
+		countriesTable,
+		model.GeolocationTableColumns.CountryAlpha2,
+		strings.Join(conditions, " and "),
+		sortExpression[0],
+		direction,
+		model.GeolocationTableColumns.ID,
+		arg(options.limit()+1),
+	), args...).Bind(ctx, conn, &found)
+	if err != nil {
+		return SearchResult{}, errors.Wrap(err, "failed to search geo locations in db")
+	}
+
+	locations := make([]Geolocation, 0, len(found))
+	for _, location := range found {
+		locations = append(locations, Geolocation{
+			Geolocation: location.Geolocation,
+			CountryRef:  location.CountryRef.country(location.CountryAlpha2),
+		})
+	}
+
+	return options.page(locations), nil
+}
+
+// matches checks if location passes options filters, prefix is parsed IP prefix filter or nil
+func (options SearchOptions) matches(location Geolocation, prefix *net.IPNet) bool {
+	if len(options.Country) != 0 && !strings.EqualFold(countryName(location), options.Country) {
+		return false
+	}
+	if len(options.CountryCode) != 0 && !strings.EqualFold(countryCode(location), options.CountryCode) {
+		return false
+	}
+	if len(options.City) != 0 && !strings.EqualFold(location.City.String, options.City) {
+		return false
+	}
+	if prefix != nil {
+		network, err := parseNetwork(location.IPAddress)
+		if err != nil || !networkContains(prefix, network) {
+			return false
+		}
+	}
+	createdAt := location.CreatedAt
+	if !options.CreatedFrom.IsZero() && (!createdAt.Valid || createdAt.Time.Before(options.CreatedFrom)) {
+		return false
+	}
+	if !options.CreatedTo.IsZero() && (!createdAt.Valid || !createdAt.Time.Before(options.CreatedTo)) {
+		return false
+	}
+
+	return true
+}
+
+// parseNetwork parses IP address or CIDR network
+func parseNetwork(address string) (*net.IPNet, error) {
+	key, err := networkKey(address)
+	if err != nil {
+		return nil, err
+	}
+	_, network, err := net.ParseCIDR(key)
+
+	return network, err
+}
+
+// networkContains checks if network is inside of prefix, the same way as postgres inet <<= operator
+func networkContains(prefix, network *net.IPNet) bool {
+	prefixLength, prefixBits := prefix.Mask.Size()
+	networkLength, networkBits := network.Mask.Size()
+
+	return prefixBits == networkBits && prefixLength <= networkLength && prefix.Contains(network.IP)
+}
+
+// compareSearchValues compares values parsed by parseSearchValue, networks are ordered as postgres orders inet
+// values: IPv4 ones first, then by address and prefix length
+func compareSearchValues(a, b interface{}) int {
+	switch a := a.(type) {
+	case time.Time:
+		b := b.(time.Time)
+		switch {
+		case a.Before(b):
+			return -1
+		case a.After(b):
+			return 1
+		}
+		return 0
+	case *net.IPNet:
+		b := b.(*net.IPNet)
+		if len(a.IP) != len(b.IP) {
+			return len(a.IP) - len(b.IP)
+		}
+		if result := bytes.Compare(a.IP, b.IP); result != 0 {
+			return result
+		}
+		aLength, _ := a.Mask.Size()
+		bLength, _ := b.Mask.Size()
+		return aLength - bLength
+	default:
+		return strings.Compare(a.(string), b.(string))
+	}
+}
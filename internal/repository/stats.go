@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/MaximChernomorov/challenge-test/internal/repository/model"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// Stats summarizes the active dataset. Countries are told apart by code as it is returned to clients: code of
+// reference country if row refers to one and imported code otherwise. Cities are told apart by country
+type Stats struct {
+	// DatasetID is id of the active dataset, 0 if there is none
+	DatasetID       int       `boil:"dataset_id" json:"dataset_id"`
+	Records         int       `boil:"records" json:"records"`
+	Countries       int       `boil:"countries" json:"countries"`
+	Cities          int       `boil:"cities" json:"cities"`
+	NewestCreatedAt null.Time `boil:"newest_created_at" json:"newest_created_at"`
+}
+
+// CountryStats summarizes rows of the active dataset referring to country, Code is empty for rows without country.
+// Bounding box is made of coordinates as they are imported
+type CountryStats struct {
+	Code         string  `boil:"code" json:"code"`
+	Name         string  `boil:"name" json:"name"`
+	Records      int     `boil:"records" json:"records"`
+	Cities       int     `boil:"cities" json:"cities"`
+	MinLatitude  float64 `boil:"min_latitude" json:"min_latitude"`
+	MinLongitude float64 `boil:"min_longitude" json:"min_longitude"`
+	MaxLatitude  float64 `boil:"max_latitude" json:"max_latitude"`
+	MaxLongitude float64 `boil:"max_longitude" json:"max_longitude"`
+}
+
+// CityStats is count of rows of the active dataset in city
+type CityStats struct {
+	CountryCode string `boil:"country_code" json:"country_code"`
+	City        string `boil:"city" json:"city"`
+	Records     int    `boil:"records" json:"records"`
+}
+
+// rowCountryCode is sql expression of country code as it is returned to clients, see countryCode
+var rowCountryCode = fmt.Sprintf("coalesce(%s, %s, '')",
+	model.GeolocationTableColumns.CountryAlpha2, model.GeolocationTableColumns.CountryCode)
+
+func stats(ctx context.Context, conn *sql.DB) (Stats, error) {
+	result := Stats{}
+	err := queries.Raw(fmt.Sprintf(
+		`select coalesce((select id from %[1]s where is_active), 0) as dataset_id,
+			count(*) as records,
+			count(distinct nullif(%[2]s, '')) as countries,
+			count(distinct (%[2]s, %[3]s)) filter (where %[3]s <> '') as cities,
+			max(%[4]s) as newest_created_at
+		from %[5]s
+		where %[6]s`,
+		datasetsTable,
+		rowCountryCode,
+		model.GeolocationTableColumns.City,
+		model.GeolocationTableColumns.CreatedAt,
+		model.TableNames.Geolocations,
+		activeDatasetCondition,
+	)).Bind(ctx, conn, &result)
+	if err != nil {
+		return result, errors.Wrap(err, "failed to get stats from db")
+	}
+
+	return result, nil
+}
+
+func countryStats(ctx context.Context, conn *sql.DB) ([]CountryStats, error) {
+	result := make([]CountryStats, 0)
+	err := queries.Raw(fmt.Sprintf(
+		`select %[1]s as code,
+			coalesce(min(c.name), min(%[2]s), '') as name,
+			count(*) as records,
+			count(distinct %[3]s) filter (where %[3]s <> '') as cities,
+			min(%[4]s[0]) as min_latitude,
+			min(%[4]s[1]) as min_longitude,
+			max(%[4]s[0]) as max_latitude,
+			max(%[4]s[1]) as max_longitude
+		from %[5]s
+		left join %[6]s c on c.code = %[7]s
+		where %[8]s
+		group by 1
+		order by records desc, code collate "C"`,
+		rowCountryCode,
+		model.GeolocationTableColumns.Country,
+		model.GeolocationTableColumns.City,
+		model.GeolocationTableColumns.Coordinates,
+		model.TableNames.Geolocations,
+		countriesTable,
+		model.GeolocationTableColumns.CountryAlpha2,
+		activeDatasetCondition,
+	)).Bind(ctx, conn, &result)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get country stats from db")
+	}
+
+	return result, nil
+}
+
+func cityStats(ctx context.Context, conn *sql.DB, countryCode string, limit int) ([]CityStats, error) {
+	result := make([]CityStats, 0)
+	if limit < 1 {
+		return result, nil
+	}
+	err := queries.Raw(fmt.Sprintf(
+		`select %[1]s as country_code, %[2]s as city, count(*) as records
+		from %[3]s
+		where %[4]s and %[2]s <> '' and ($1::text = '' or upper(%[1]s) = upper($1::text))
+		group by 1, 2
+		order by records desc, country_code collate "C", city collate "C"
+		limit $2`,
+		rowCountryCode,
+		model.GeolocationTableColumns.City,
+		model.TableNames.Geolocations,
+		activeDatasetCondition,
+	), countryCode, limit).Bind(ctx, conn, &result)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get city stats from db")
+	}
+
+	return result, nil
+}
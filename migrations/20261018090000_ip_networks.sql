@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- ip_address holds both single addresses (/32, /128) and networks, lookups use containment operators
+create index if not exists geolocations_ip_address_gist_idx
+    on public.geolocations using gist (ip_address inet_ops);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+drop index if exists public.geolocations_ip_address_gist_idx;
+-- +goose StatementEnd
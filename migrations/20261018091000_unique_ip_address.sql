@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- repeated imports could have duplicated rows, keep the oldest one
+delete
+from public.geolocations g
+    using public.geolocations d
+where g.ip_address = d.ip_address
+  and g.id > d.id;
+
+create unique index if not exists geolocations_ip_address_uindex
+    on public.geolocations (ip_address);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+drop index if exists public.geolocations_ip_address_uindex;
+-- +goose StatementEnd
@@ -0,0 +1,60 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+create table if not exists public.datasets
+(
+    id          serial
+        constraint datasets_pk primary key,
+    rows_count  integer     not null default 0,
+    is_active   boolean     not null default false,
+    created_at  timestamptz not null default now(),
+    promoted_at timestamptz
+);
+
+-- only one dataset is served to readers at a time
+create unique index if not exists datasets_is_active_uindex
+    on public.datasets (is_active) where is_active;
+
+-- existing rows become the first active dataset
+insert into public.datasets (rows_count, is_active, promoted_at)
+select count(*), true, now()
+from public.geolocations;
+
+alter table public.geolocations
+    add column dataset_id integer;
+update public.geolocations
+set dataset_id = (select id from public.datasets where is_active);
+alter table public.geolocations
+    alter column dataset_id set not null;
+
+drop index if exists public.geolocations_ip_address_uindex;
+create unique index if not exists geolocations_dataset_id_ip_address_uindex
+    on public.geolocations (dataset_id, ip_address);
+
+create extension if not exists btree_gist;
+drop index if exists public.geolocations_ip_address_gist_idx;
+create index if not exists geolocations_dataset_id_ip_address_gist_idx
+    on public.geolocations using gist (dataset_id, ip_address inet_ops);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+delete
+from public.geolocations
+where dataset_id <> (select coalesce(max(id), 0) from public.datasets where is_active);
+
+drop index if exists public.geolocations_dataset_id_ip_address_gist_idx;
+create index if not exists geolocations_ip_address_gist_idx
+    on public.geolocations using gist (ip_address inet_ops);
+drop index if exists public.geolocations_dataset_id_ip_address_uindex;
+create unique index if not exists geolocations_ip_address_uindex
+    on public.geolocations (ip_address);
+
+alter table public.geolocations
+    drop column if exists dataset_id;
+drop table if exists public.datasets;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- nearest neighbour lookups order rows of a dataset by coordinates <-> point
+create index if not exists geolocations_dataset_id_coordinates_gist_idx
+    on public.geolocations using gist (dataset_id, coordinates);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+drop index if exists public.geolocations_dataset_id_coordinates_gist_idx;
+-- +goose StatementEnd
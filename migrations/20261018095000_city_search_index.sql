@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- support searches match city case-insensitively within the active dataset
+create index if not exists geolocations_dataset_id_lower_city_idx
+    on public.geolocations (dataset_id, lower(city));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+drop index if exists public.geolocations_dataset_id_lower_city_idx;
+-- +goose StatementEnd
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- every import run, rows of running imports have no finished_at
+create table if not exists public.imports
+(
+    id                  serial
+        constraint imports_pk primary key,
+    source              text        not null,
+    checksum            text,
+    status              text        not null,
+    started_at          timestamptz not null default now(),
+    finished_at         timestamptz,
+    accepted            integer     not null default 0,
+    discarded           integer     not null default 0,
+    discarded_by_reason jsonb       not null default '{}',
+    dataset_id          integer
+        constraint imports_dataset_id_fk references public.datasets on delete set null,
+    error               text
+);
+
+create index if not exists imports_started_at_idx
+    on public.imports (started_at desc);
+
+-- import which wrote the row, rows written before imports were recorded have none
+alter table public.geolocations
+    add column import_id integer
+        constraint geolocations_import_id_fk references public.imports;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+alter table public.geolocations
+    drop column if exists import_id;
+drop table if exists public.imports;
+-- +goose StatementEnd
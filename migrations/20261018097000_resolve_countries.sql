@@ -0,0 +1,145 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- existing rows are resolved to reference countries like import does: country name wins if it disagrees with
+-- country code, code is used if name is unknown. Names are matched with aliases frozen below and normalized by
+-- lowercasing, folding diacritics, "&" to "and" and dropping everything except letters and digits
+create function pg_temp.normalize_country_name(name text) returns text
+    language sql
+    immutable
+as
+$$
+select regexp_replace(
+               replace(lower(translate(name, 'ÅåÁáÃãÇçÉéÍíÔôÜü', 'AaAaAaCcEeIiOoUu')), '&', 'and'),
+               '[^a-z0-9]', '', 'g')
+$$;
+
+create temporary table country_names on commit drop as
+select code, pg_temp.normalize_country_name(name) as name
+from public.countries;
+
+insert into country_names (code, name)
+select code, pg_temp.normalize_country_name(alias)
+from (values ('AE', 'UAE'),
+       ('AG', 'Antigua & Barbuda'),
+       ('AX', 'Åland Islands'),
+       ('BA', 'Bosnia & Herzegovina'),
+       ('BL', 'St. Barthélemy'),
+       ('BL', 'Saint Barthélemy'),
+       ('BN', 'Brunei Darussalam'),
+       ('BO', 'Bolivia, Plurinational State of'),
+       ('BO', 'Plurinational State of Bolivia'),
+       ('BQ', 'Caribbean Netherlands'),
+       ('BS', 'The Bahamas'),
+       ('BS', 'Bahamas, The'),
+       ('BV', 'Bouvet Island (Bouvetoya)'),
+       ('CC', 'Cocos Islands'),
+       ('CD', 'Democratic Republic of the Congo'),
+       ('CD', 'DR Congo'),
+       ('CD', 'Congo - Kinshasa'),
+       ('CD', 'Congo (Kinshasa)'),
+       ('CG', 'Republic of the Congo'),
+       ('CG', 'Congo - Brazzaville'),
+       ('CG', 'Congo (Brazzaville)'),
+       ('CI', 'Ivory Coast'),
+       ('CI', 'Côte d’Ivoire'),
+       ('CI', 'Côte d''Ivoire'),
+       ('CV', 'Cape Verde'),
+       ('CW', 'Curaçao'),
+       ('CZ', 'Czech Republic'),
+       ('FK', 'Falkland Islands (Malvinas)'),
+       ('FM', 'Micronesia, Federated States of'),
+       ('FM', 'Federated States of Micronesia'),
+       ('FO', 'Faeroe Islands'),
+       ('GB', 'United Kingdom of Great Britain and Northern Ireland'),
+       ('GB', 'Great Britain'),
+       ('GB', 'UK'),
+       ('GM', 'The Gambia'),
+       ('GM', 'Gambia, The'),
+       ('GS', 'South Georgia & South Sandwich Islands'),
+       ('HK', 'Hong Kong SAR China'),
+       ('HK', 'Hong Kong SAR'),
+       ('HM', 'Heard & McDonald Islands'),
+       ('IO', 'British Indian Ocean Territory (Chagos Archipelago)'),
+       ('IR', 'Iran, Islamic Republic of'),
+       ('IR', 'Islamic Republic of Iran'),
+       ('KG', 'Kyrgyz Republic'),
+       ('KN', 'St. Kitts & Nevis'),
+       ('KP', 'Korea, Democratic People''s Republic of'),
+       ('KP', 'Democratic People''s Republic of Korea'),
+       ('KR', 'Korea, Republic of'),
+       ('KR', 'Republic of Korea'),
+       ('KR', 'Korea'),
+       ('LA', 'Lao People''s Democratic Republic'),
+       ('LC', 'St. Lucia'),
+       ('LY', 'Libyan Arab Jamahiriya'),
+       ('MD', 'Moldova, Republic of'),
+       ('MD', 'Republic of Moldova'),
+       ('MF', 'Saint Martin (French part)'),
+       ('MF', 'St. Martin'),
+       ('MK', 'Macedonia'),
+       ('MK', 'Republic of North Macedonia'),
+       ('MM', 'Myanmar (Burma)'),
+       ('MM', 'Burma'),
+       ('MO', 'Macau'),
+       ('MO', 'Macao SAR China'),
+       ('MO', 'Macau SAR China'),
+       ('NL', 'Netherlands, The'),
+       ('NL', 'The Netherlands'),
+       ('PM', 'St. Pierre & Miquelon'),
+       ('PN', 'Pitcairn Islands'),
+       ('PS', 'Palestine, State of'),
+       ('PS', 'Palestinian Territories'),
+       ('PS', 'Palestinian Territory'),
+       ('PS', 'State of Palestine'),
+       ('RE', 'Réunion'),
+       ('RU', 'Russian Federation'),
+       ('SH', 'Saint Helena, Ascension and Tristan da Cunha'),
+       ('SH', 'St. Helena'),
+       ('SJ', 'Svalbard & Jan Mayen Islands'),
+       ('SJ', 'Svalbard & Jan Mayen'),
+       ('SK', 'Slovakia (Slovak Republic)'),
+       ('SK', 'Slovak Republic'),
+       ('ST', 'São Tomé & Príncipe'),
+       ('SX', 'Sint Maarten (Dutch part)'),
+       ('SY', 'Syrian Arab Republic'),
+       ('SZ', 'Swaziland'),
+       ('TC', 'Turks & Caicos Islands'),
+       ('TL', 'East Timor'),
+       ('TR', 'Türkiye'),
+       ('TR', 'Turkiye'),
+       ('TT', 'Trinidad & Tobago'),
+       ('TW', 'Taiwan, Province of China'),
+       ('TZ', 'Tanzania, United Republic of'),
+       ('TZ', 'United Republic of Tanzania'),
+       ('UM', 'U.S. Outlying Islands'),
+       ('US', 'United States of America'),
+       ('US', 'USA'),
+       ('VA', 'Vatican City'),
+       ('VA', 'Holy See (Vatican City State)'),
+       ('VA', 'Vatican City State'),
+       ('VC', 'St. Vincent & Grenadines'),
+       ('VE', 'Venezuela, Bolivarian Republic of'),
+       ('VE', 'Bolivarian Republic of Venezuela'),
+       ('VG', 'British Virgin Islands'),
+       ('VI', 'U.S. Virgin Islands'),
+       ('VI', 'United States Virgin Islands'),
+       ('VN', 'Viet Nam'),
+       ('WF', 'Wallis & Futuna')) as aliases (code, alias);
+
+update public.geolocations g
+set country_alpha2 = coalesce(
+        (select n.code from country_names n where n.name = pg_temp.normalize_country_name(g.country)),
+        (select c.code from public.countries c where c.code = g.country_code))
+where g.country_alpha2 is null;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+update public.geolocations
+set country_alpha2 = null;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+// Package migrations embeds SQL migrations of db schema, so they are shipped with the binary
+package migrations
+
+import "embed"
+
+// FS contains goose SQL migrations
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,56 @@
+package exporter
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/MaximChernomorov/challenge-test/pkg/importer"
+	"github.com/friendsofgo/errors"
+)
+
+// csvHeader is header expected by importer.CSVImporter
+var csvHeader = []string{"ip_address", "country_code", "country", "city", "latitude", "longitude", "mystery_value"}
+
+// CSVExporter writes rows as csv readable by importer.CSVImporter. Coordinates are written in the shortest form
+// which is parsed back into the same values, so exported rows are imported back unchanged
+type CSVExporter struct {
+	writer *csv.Writer
+}
+
+// NewCSVExporter writes header right away, so export of no rows is valid csv too
+func NewCSVExporter(destination io.Writer) (Exporter, error) {
+	writer := csv.NewWriter(destination)
+	err := writer.Write(csvHeader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to write csv header")
+	}
+
+	return &CSVExporter{writer: writer}, nil
+}
+
+func (exporter *CSVExporter) Write(rows []importer.CSVRow) error {
+	for _, row := range rows {
+		err := exporter.writer.Write([]string{
+			row.IPAddress,
+			row.CountryCode,
+			row.Country,
+			row.City,
+			strconv.FormatFloat(row.Latitude, 'f', -1, 64),
+			strconv.FormatFloat(row.Longitude, 'f', -1, 64),
+			row.MysteryValue,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to export %s", row.IPAddress)
+		}
+	}
+	exporter.writer.Flush()
+
+	return errors.Wrap(exporter.writer.Error(), "failed to write csv")
+}
+
+func (exporter *CSVExporter) Close() error {
+	exporter.writer.Flush()
+
+	return errors.Wrap(exporter.writer.Error(), "failed to write csv")
+}
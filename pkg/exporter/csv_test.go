@@ -0,0 +1,52 @@
+package exporter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MaximChernomorov/challenge-test/pkg/importer"
+	"github.com/stretchr/testify/require"
+)
+
+// roundTripRows are valid rows with values which are easy to mangle: quotes, separators, long floats and networks
+func roundTripRows() []importer.CSVRow {
+	return []importer.CSVRow{
+		mmdbTestRow("192.184.51.218", "Willburgh"),
+		mmdbTestRow("10.0.0.0/8", `New "Quoted", City`),
+		mmdbTestRow("2001:db8::/32", " Leading space"),
+		{
+			IPAddress:    "2001:db8::1",
+			CountryCode:  "NP",
+			Country:      "Nepal",
+			City:         "Line\nbreak",
+			Latitude:     -84.87503094689836,
+			Longitude:    7.206435933364332e-05,
+			MysteryValue: "",
+		},
+	}
+}
+
+func TestCSVExporter_RoundTrip(t *testing.T) {
+	destination := &bytes.Buffer{}
+	exporter, err := NewCSVExporter(destination)
+	require.Nil(t, err)
+	rows := roundTripRows()
+	require.Nil(t, exporter.Write(rows[:2]))
+	require.Nil(t, exporter.Write(rows[2:]))
+	require.Nil(t, exporter.Close())
+	require.Contains(t, destination.String(), "ip_address,country_code,country,city,latitude,longitude,mystery_value\n")
+
+	imported := &importer.CSVRows{}
+	err = importer.GetCSVImporter().Import(destination, imported)
+	require.Nil(t, err)
+	require.Equal(t, 0, imported.GetDiscardedCnt())
+	require.Equal(t, rows, imported.GetRows())
+}
+
+func TestCSVExporter_Empty(t *testing.T) {
+	destination := &bytes.Buffer{}
+	exporter, err := NewCSVExporter(destination)
+	require.Nil(t, err)
+	require.Nil(t, exporter.Close())
+	require.Equal(t, "ip_address,country_code,country,city,latitude,longitude,mystery_value\n", destination.String())
+}
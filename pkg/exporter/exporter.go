@@ -0,0 +1,12 @@
+package exporter
+
+import (
+	"github.com/MaximChernomorov/challenge-test/pkg/importer"
+)
+
+type Exporter interface {
+	// Write exports batch of rows
+	Write(rows []importer.CSVRow) error
+	// Close finishes export, destination is complete only after it
+	Close() error
+}
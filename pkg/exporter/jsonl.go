@@ -0,0 +1,40 @@
+package exporter
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	"github.com/MaximChernomorov/challenge-test/pkg/importer"
+	"github.com/friendsofgo/errors"
+)
+
+// JSONLExporter writes rows as JSON Lines readable by importer.JSONLImporter, one object per row
+type JSONLExporter struct {
+	buffered *bufio.Writer
+	encoder  *json.Encoder
+}
+
+func NewJSONLExporter(destination io.Writer) (Exporter, error) {
+	buffered := bufio.NewWriter(destination)
+	encoder := json.NewEncoder(buffered)
+	// text fields are written as is, e.g. city names with & or <
+	encoder.SetEscapeHTML(false)
+
+	return &JSONLExporter{buffered: buffered, encoder: encoder}, nil
+}
+
+func (exporter *JSONLExporter) Write(rows []importer.CSVRow) error {
+	for _, row := range rows {
+		err := exporter.encoder.Encode(row)
+		if err != nil {
+			return errors.Wrapf(err, "failed to export %s", row.IPAddress)
+		}
+	}
+
+	return nil
+}
+
+func (exporter *JSONLExporter) Close() error {
+	return errors.Wrap(exporter.buffered.Flush(), "failed to write json lines")
+}
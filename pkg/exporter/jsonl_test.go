@@ -0,0 +1,25 @@
+package exporter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MaximChernomorov/challenge-test/pkg/importer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONLExporter_RoundTrip(t *testing.T) {
+	destination := &bytes.Buffer{}
+	exporter, err := NewJSONLExporter(destination)
+	require.Nil(t, err)
+	rows := roundTripRows()
+	require.Nil(t, exporter.Write(rows))
+	require.Nil(t, exporter.Close())
+	require.Equal(t, len(rows), bytes.Count(destination.Bytes(), []byte("\n")))
+
+	imported := &importer.CSVRows{}
+	err = importer.GetJSONLImporter().Import(destination, imported)
+	require.Nil(t, err)
+	require.Equal(t, 0, imported.GetDiscardedCnt())
+	require.Equal(t, rows, imported.GetRows())
+}
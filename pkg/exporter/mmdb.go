@@ -0,0 +1,103 @@
+package exporter
+
+import (
+	"io"
+	"net"
+	"sort"
+
+	"github.com/MaximChernomorov/challenge-test/pkg/importer"
+	"github.com/friendsofgo/errors"
+	"github.com/maxmind/mmdbwriter"
+	"github.com/maxmind/mmdbwriter/mmdbtype"
+)
+
+// DefaultMMDBDatabaseType is recognized by GeoIP2 City readers, as records follow its layout
+const DefaultMMDBDatabaseType = "GeoLite2-City"
+
+type MMDBOptions struct {
+	// DatabaseType is written into db metadata, readers use it to choose record layout
+	DatabaseType string
+	// Description is english description written into db metadata
+	Description string
+}
+
+// MMDBExporter writes rows as MaxMind DB in GeoIP2 City layout (see importer.MMDBRecord)
+type MMDBExporter struct {
+	destination io.Writer
+	tree        *mmdbwriter.Tree
+	networks    []mmdbNetwork
+}
+
+type mmdbNetwork struct {
+	network *net.IPNet
+	record  mmdbtype.Map
+}
+
+func NewMMDBExporter(destination io.Writer, options MMDBOptions) (Exporter, error) {
+	if len(options.DatabaseType) == 0 {
+		options.DatabaseType = DefaultMMDBDatabaseType
+	}
+	tree, err := mmdbwriter.New(mmdbwriter.Options{
+		DatabaseType: options.DatabaseType,
+		Description:  map[string]string{importer.MMDBLanguage: options.Description},
+		Languages:    []string{importer.MMDBLanguage},
+		// private networks are valid rows too
+		IncludeReservedNetworks: true,
+		RecordSize:              28,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create mmdb")
+	}
+
+	return &MMDBExporter{destination: destination, tree: tree}, nil
+}
+
+// Write collects rows, they are inserted into db on Close
+func (exporter *MMDBExporter) Write(rows []importer.CSVRow) error {
+	for _, row := range rows {
+		networks, err := row.Networks()
+		if err != nil {
+			return errors.Wrapf(err, "failed to export %s", row.IPAddress)
+		}
+		record := mmdbtype.Map{
+			"city": mmdbtype.Map{
+				"names": mmdbtype.Map{importer.MMDBLanguage: mmdbtype.String(row.City)},
+			},
+			"country": mmdbtype.Map{
+				"iso_code": mmdbtype.String(row.CountryCode),
+				"names":    mmdbtype.Map{importer.MMDBLanguage: mmdbtype.String(row.Country)},
+			},
+			"location": mmdbtype.Map{
+				"latitude":  mmdbtype.Float64(row.Latitude),
+				"longitude": mmdbtype.Float64(row.Longitude),
+			},
+			"mystery_value": mmdbtype.String(row.MysteryValue),
+		}
+		for _, network := range networks {
+			exporter.networks = append(exporter.networks, mmdbNetwork{network: network, record: record})
+		}
+	}
+
+	return nil
+}
+
+// Close inserts collected networks and writes db. Less specific networks are inserted first, so more specific ones
+// nested into them override their records like in lookup by longest prefix
+func (exporter *MMDBExporter) Close() error {
+	sort.SliceStable(exporter.networks, func(i, j int) bool {
+		iOnes, _ := exporter.networks[i].network.Mask.Size()
+		jOnes, _ := exporter.networks[j].network.Mask.Size()
+		return iOnes < jOnes
+	})
+	for _, network := range exporter.networks {
+		err := exporter.tree.Insert(network.network, network.record)
+		if err != nil {
+			return errors.Wrapf(err, "failed to insert %s into mmdb", network.network)
+		}
+	}
+	exporter.networks = nil
+
+	_, err := exporter.tree.WriteTo(exporter.destination)
+
+	return errors.Wrap(err, "failed to write mmdb")
+}
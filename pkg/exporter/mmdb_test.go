@@ -0,0 +1,90 @@
+package exporter
+
+import (
+	"bytes"
+	"net"
+	"testing"
+
+	"github.com/MaximChernomorov/challenge-test/pkg/importer"
+	"github.com/oschwald/maxminddb-golang"
+	"github.com/stretchr/testify/require"
+)
+
+func mmdbTestRow(ip, city string) importer.CSVRow {
+	return importer.CSVRow{
+		IPAddress:    ip,
+		CountryCode:  "RU",
+		Country:      "Morocco",
+		City:         city,
+		Latitude:     76.7892707471672,
+		Longitude:    -8.617777079132821,
+		MysteryValue: "2815330924",
+	}
+}
+
+func exportMMDB(t *testing.T, rows ...importer.CSVRow) []byte {
+	destination := &bytes.Buffer{}
+	exporter, err := NewMMDBExporter(destination, MMDBOptions{Description: "test"})
+	require.Nil(t, err)
+	require.Nil(t, exporter.Write(rows))
+	require.Nil(t, exporter.Close())
+
+	return destination.Bytes()
+}
+
+func TestMMDBExporter_RoundTrip(t *testing.T) {
+	rows := []importer.CSVRow{
+		mmdbTestRow("192.184.51.218", "Willburgh"),
+		mmdbTestRow("10.0.0.0/8", "Ten"),
+		mmdbTestRow("2001:db8::/32", "Documentation"),
+	}
+	db := exportMMDB(t, rows...)
+
+	imported := &importer.CSVRows{}
+	err := importer.GetMMDBImporter().Import(bytes.NewReader(db), imported)
+	require.Nil(t, err)
+	require.Equal(t, 0, imported.GetDiscardedCnt())
+	require.ElementsMatch(t, rows, imported.GetRows())
+}
+
+func TestMMDBExporter_NestedNetworks(t *testing.T) {
+	// the most specific network is written first to check that insertion order doesn't matter
+	db := exportMMDB(t,
+		mmdbTestRow("10.1.2.3", "Host"),
+		mmdbTestRow("10.1.0.0/16", "TenOne"),
+		mmdbTestRow("10.0.0.0/8", "Ten"),
+	)
+
+	reader, err := maxminddb.FromBytes(db)
+	require.Nil(t, err)
+	require.Equal(t, DefaultMMDBDatabaseType, reader.Metadata.DatabaseType)
+	tests := map[string]string{
+		"10.1.2.3":   "Host",
+		"10.1.200.1": "TenOne",
+		"10.200.0.1": "Ten",
+		"11.0.0.1":   "",
+	}
+	for ip, city := range tests {
+		record := importer.MMDBRecord{}
+		err = reader.Lookup(net.ParseIP(ip), &record)
+		require.Nil(t, err)
+		require.Equal(t, city, record.City.Names[importer.MMDBLanguage], ip)
+	}
+
+	imported := &importer.CSVRows{}
+	err = importer.GetMMDBImporter().Import(bytes.NewReader(db), imported)
+	require.Nil(t, err)
+	cities := make(map[string]int)
+	for _, row := range imported.GetRows() {
+		cities[row.City]++
+	}
+	// networks are disjoint in mmdb, so outer ones are split around nested ones
+	require.Equal(t, 1, cities["Host"])
+	require.Equal(t, 16, cities["TenOne"])
+	require.Equal(t, 8, cities["Ten"])
+}
+
+func TestMMDBImporter_InvalidSource(t *testing.T) {
+	err := importer.GetMMDBImporter().Import(bytes.NewReader([]byte("not a db")), &importer.CSVRows{})
+	require.NotNil(t, err)
+}
@@ -0,0 +1,65 @@
+package exporter
+
+import (
+	"io"
+
+	"github.com/MaximChernomorov/challenge-test/pkg/importer"
+	"github.com/friendsofgo/errors"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// parquetParallelism is count of goroutines encoding row groups
+const parquetParallelism = 4
+
+// parquetRow is parquet schema of exported rows, columns are named like csv header. Country and city columns are
+// dictionary encoded as they have few distinct values
+type parquetRow struct {
+	IPAddress    string  `parquet:"name=ip_address, type=BYTE_ARRAY, convertedtype=UTF8"`
+	CountryCode  string  `parquet:"name=country_code, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Country      string  `parquet:"name=country, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	City         string  `parquet:"name=city, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Latitude     float64 `parquet:"name=latitude, type=DOUBLE"`
+	Longitude    float64 `parquet:"name=longitude, type=DOUBLE"`
+	MysteryValue string  `parquet:"name=mystery_value, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+// ParquetExporter writes rows as snappy compressed parquet file, rows are buffered in memory until row group is
+// complete
+type ParquetExporter struct {
+	writer *writer.ParquetWriter
+}
+
+func NewParquetExporter(destination io.Writer) (Exporter, error) {
+	parquetWriter, err := writer.NewParquetWriterFromWriter(destination, new(parquetRow), parquetParallelism)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create parquet writer")
+	}
+	parquetWriter.CompressionType = parquet.CompressionCodec_SNAPPY
+
+	return &ParquetExporter{writer: parquetWriter}, nil
+}
+
+func (exporter *ParquetExporter) Write(rows []importer.CSVRow) error {
+	for _, row := range rows {
+		err := exporter.writer.Write(parquetRow{
+			IPAddress:    row.IPAddress,
+			CountryCode:  row.CountryCode,
+			Country:      row.Country,
+			City:         row.City,
+			Latitude:     row.Latitude,
+			Longitude:    row.Longitude,
+			MysteryValue: row.MysteryValue,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to export %s", row.IPAddress)
+		}
+	}
+
+	return nil
+}
+
+// Close writes the last row group and file footer
+func (exporter *ParquetExporter) Close() error {
+	return errors.Wrap(exporter.writer.WriteStop(), "failed to write parquet")
+}
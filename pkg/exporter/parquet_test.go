@@ -0,0 +1,44 @@
+package exporter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MaximChernomorov/challenge-test/pkg/importer"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+func TestParquetExporter(t *testing.T) {
+	destination := &bytes.Buffer{}
+	exporter, err := NewParquetExporter(destination)
+	require.Nil(t, err)
+	rows := roundTripRows()
+	require.Nil(t, exporter.Write(rows[:1]))
+	require.Nil(t, exporter.Write(rows[1:]))
+	require.Nil(t, exporter.Close())
+
+	file, err := buffer.NewBufferFile(destination.Bytes())
+	require.Nil(t, err)
+	parquetReader, err := reader.NewParquetReader(file, new(parquetRow), 1)
+	require.Nil(t, err)
+	defer parquetReader.ReadStop()
+	require.Equal(t, int64(len(rows)), parquetReader.GetNumRows())
+	read := make([]parquetRow, len(rows))
+	require.Nil(t, parquetReader.Read(&read))
+
+	exported := make([]importer.CSVRow, 0, len(read))
+	for _, row := range read {
+		exported = append(exported, importer.CSVRow{
+			IPAddress:    row.IPAddress,
+			CountryCode:  row.CountryCode,
+			Country:      row.Country,
+			City:         row.City,
+			Latitude:     row.Latitude,
+			Longitude:    row.Longitude,
+			MysteryValue: row.MysteryValue,
+		})
+	}
+	require.Equal(t, rows, exported)
+}
@@ -0,0 +1,125 @@
+package importer
+
+import (
+	_ "embed"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/jszwec/csvutil"
+)
+
+// countriesCSV is ISO 3166-1 reference table with codes, continent, english short name and alternative names of every
+// country
+//
+//go:embed countries.csv
+var countriesCSV []byte
+
+// Country is ISO 3166-1 country from embedded reference table
+type Country struct {
+	// Code is ISO 3166-1 alpha-2 code
+	Code string `csv:"country_code"`
+	// Alpha3 is ISO 3166-1 alpha-3 code
+	Alpha3 string `csv:"alpha3"`
+	// Numeric is ISO 3166-1 numeric code with leading zeros
+	Numeric string `csv:"numeric"`
+	// Continent is two letter continent code: AF, AN, AS, EU, NA, OC or SA
+	Continent string `csv:"continent"`
+	// Name is canonical english short name
+	Name string `csv:"name"`
+	// Aliases are other names the country is known by, e.g. official ISO 3166 name
+	Aliases []string `csv:"-"`
+}
+
+var (
+	countriesOnce sync.Once
+	countries     []Country
+	countryByCode map[string]Country
+	// countryByName is keyed by normalized names and aliases, see normalizeCountryName
+	countryByName map[string]Country
+)
+
+// Countries returns all countries of embedded reference table ordered by code
+func Countries() []Country {
+	loadCountries()
+
+	return countries
+}
+
+// LookupCountry returns country by ISO 3166-1 alpha-2 code, code is case-sensitive
+func LookupCountry(code string) (Country, bool) {
+	loadCountries()
+	country, found := countryByCode[code]
+
+	return country, found
+}
+
+// LookupCountryByName returns country by name or alias, see Country.MatchesName
+func LookupCountryByName(name string) (Country, bool) {
+	loadCountries()
+	country, found := countryByName[normalizeCountryName(name)]
+
+	return country, found
+}
+
+// MatchesName reports if name is the country name or one of its aliases. Case, diacritics, punctuation and "&"
+// instead of "and" are ignored
+func (country Country) MatchesName(name string) bool {
+	normalized := normalizeCountryName(name)
+	if normalized == normalizeCountryName(country.Name) {
+		return true
+	}
+	for _, alias := range country.Aliases {
+		if normalized == normalizeCountryName(alias) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// loadCountries parses embedded table once, it is validated by tests, so parse error is a programming error
+func loadCountries() {
+	countriesOnce.Do(func() {
+		var records []struct {
+			Country
+			Aliases string `csv:"aliases"`
+		}
+		err := csvutil.Unmarshal(countriesCSV, &records)
+		if err != nil {
+			panic(err)
+		}
+		countries = make([]Country, 0, len(records))
+		countryByCode = make(map[string]Country, len(records))
+		countryByName = make(map[string]Country, len(records))
+		for _, record := range records {
+			country := record.Country
+			if len(record.Aliases) != 0 {
+				country.Aliases = strings.Split(record.Aliases, "|")
+			}
+			countries = append(countries, country)
+			countryByCode[country.Code] = country
+			for _, name := range append([]string{country.Name}, country.Aliases...) {
+				countryByName[normalizeCountryName(name)] = country
+			}
+		}
+	})
+}
+
+var diacriticsReplacer = strings.NewReplacer(
+	"å", "a", "á", "a", "ã", "a", "ç", "c", "é", "e", "í", "i", "ô", "o", "ü", "u",
+	"&", "and", "’", "'",
+)
+
+// normalizeCountryName lowercases name, folds diacritics and drops everything except letters and digits
+func normalizeCountryName(name string) string {
+	name = diacriticsReplacer.Replace(strings.ToLower(name))
+	normalized := strings.Builder{}
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			normalized.WriteRune(r)
+		}
+	}
+
+	return normalized.String()
+}
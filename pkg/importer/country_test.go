@@ -0,0 +1,77 @@
+package importer
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountries(t *testing.T) {
+	countries := Countries()
+	require.Len(t, countries, 249)
+	codeRegexp := regexp.MustCompile(`^[A-Z]{2}$`)
+	alpha3Regexp := regexp.MustCompile(`^[A-Z]{3}$`)
+	numericRegexp := regexp.MustCompile(`^[0-9]{3}$`)
+	continents := []string{"AF", "AN", "AS", "EU", "NA", "OC", "SA"}
+	codes := make(map[string]struct{}, len(countries))
+	for i, country := range countries {
+		require.Regexp(t, codeRegexp, country.Code)
+		require.Regexp(t, alpha3Regexp, country.Alpha3)
+		require.Regexp(t, numericRegexp, country.Numeric)
+		require.Contains(t, continents, country.Continent)
+		require.NotEmpty(t, country.Name)
+		require.NotContains(t, codes, country.Code)
+		codes[country.Code] = struct{}{}
+		if i != 0 {
+			require.Less(t, countries[i-1].Code, country.Code)
+		}
+	}
+}
+
+func TestCountry_MatchesName(t *testing.T) {
+	tests := []struct {
+		code     string
+		name     string
+		expected bool
+	}{
+		{code: "RU", name: "Russia", expected: true},
+		{code: "RU", name: "Russian Federation", expected: true},
+		{code: "RU", name: "Morocco", expected: false},
+		{code: "SK", name: "Slovakia (Slovak Republic)", expected: true},
+		{code: "KR", name: "Korea, Republic of", expected: true},
+		{code: "KR", name: "north korea", expected: false},
+		{code: "CI", name: "Côte d’Ivoire", expected: true},
+		{code: "BA", name: "Bosnia & Herzegovina", expected: true},
+		{code: "BA", name: "BOSNIA AND HERZEGOVINA", expected: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code+" "+tt.name, func(t *testing.T) {
+			country, found := LookupCountry(tt.code)
+			require.True(t, found)
+			require.Equal(t, tt.expected, country.MatchesName(tt.name))
+		})
+	}
+}
+
+func TestCSVRow_ResolveCountry(t *testing.T) {
+	tests := []struct {
+		name        string
+		row         CSVRow
+		expected    string
+		expectFound bool
+	}{
+		{name: "consistent", row: CSVRow{CountryCode: "RU", Country: "Russia"}, expected: "RU", expectFound: true},
+		{name: "name wins", row: CSVRow{CountryCode: "RU", Country: "Morocco"}, expected: "MA", expectFound: true},
+		{name: "unknown name", row: CSVRow{CountryCode: "RU", Country: "Atlantis"}, expected: "RU", expectFound: true},
+		{name: "unknown code", row: CSVRow{CountryCode: "XX", Country: "Russian Federation"}, expected: "RU", expectFound: true},
+		{name: "unknown", row: CSVRow{CountryCode: "XX", Country: "Atlantis"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			country, found := tt.row.ResolveCountry()
+			require.Equal(t, tt.expectFound, found)
+			require.Equal(t, tt.expected, country.Code)
+		})
+	}
+}
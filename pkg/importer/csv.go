@@ -10,18 +10,23 @@ import (
 )
 
 type CSVRow struct {
-	IPAddress    string  `csv:"ip_address"`
-	CountryCode  string  `csv:"country_code"`
-	Country      string  `csv:"country"`
-	City         string  `csv:"city"`
-	Latitude     float64 `csv:"latitude"`
-	Longitude    float64 `csv:"longitude"`
-	MysteryValue string  `csv:"mystery_value"`
+	// IPAddress is single IP, CIDR network or start of IP range if IPAddressEnd is set
+	IPAddress    string  `csv:"ip_address" json:"ip_address"`
+	IPAddressEnd string  `csv:"ip_address_end,omitempty" json:"ip_address_end,omitempty"`
+	CountryCode  string  `csv:"country_code" json:"country_code"`
+	Country      string  `csv:"country" json:"country"`
+	City         string  `csv:"city" json:"city"`
+	Latitude     float64 `csv:"latitude" json:"latitude"`
+	Longitude    float64 `csv:"longitude" json:"longitude"`
+	MysteryValue string  `csv:"mystery_value" json:"mystery_value"`
 }
 
 type CSVRows struct {
 	rows               []CSVRow
 	rowsDiscardedCount int
+	discardedByReason  map[DiscardReason]int
+	resolver           *duplicateResolver
+	validator          *Validator
 }
 
 type CSVImporter struct{}
@@ -38,33 +43,39 @@ func (CSVImporter *CSVImporter) Import(source io.Reader, rows ImportedRows) erro
 		return errors.Wrap(err, "create decoder")
 	}
 
-	uniquenessMap := make(map[string]struct{})
 	for {
 		row := CSVRow{}
-		if err := decoder.Decode(&row); err == io.EOF {
+		decodeErr := decoder.Decode(&row)
+		if decodeErr == io.EOF {
 			break
-		} else if err != nil {
-			rows.IncrementDiscardedCnt()
-			continue
 		}
-		if !row.IsValid() {
-			rows.IncrementDiscardedCnt()
-			continue
+		reason := DiscardReason("")
+		if decodeErr != nil {
+			reason = DiscardReasonDecodeError
 		}
-		if _, exists := uniquenessMap[row.IPAddress]; exists {
-			rows.IncrementDiscardedCnt()
-			continue
-		}
-		uniquenessMap[row.IPAddress] = struct{}{}
-		err = rows.addRow(row)
+		err := collectRow(rows, row, reason, func() (int, string) {
+			return csvRecordPosition(csvReader, decoder, decodeErr)
+		})
 		if err != nil {
-			return errors.Wrap(err, "failed to add row")
+			return err
 		}
 	}
 
 	return nil
 }
 
+// csvRecordPosition returns line number and raw contents of the record decoded last
+func csvRecordPosition(reader *csv2.Reader, decoder *csvutil.Decoder, decodeErr error) (int, string) {
+	raw := joinCSVRecord(decoder.Record())
+	parseErr := &csv2.ParseError{}
+	if errors.As(decodeErr, &parseErr) {
+		return parseErr.StartLine, raw
+	}
+	line, _ := reader.FieldPos(0)
+
+	return line, raw
+}
+
 func (csvRows *CSVRows) addRow(row interface{}) error {
 	csvRow, isCorrectType := row.(CSVRow)
 	if !isCorrectType {
@@ -75,6 +86,32 @@ func (csvRows *CSVRows) addRow(row interface{}) error {
 	return nil
 }
 
+// SetDuplicatePolicy sets policy applied to rows with the same network, first-wins is used by default
+func (csvRows *CSVRows) SetDuplicatePolicy(policy DuplicatePolicy) {
+	csvRows.resolver = newDuplicateResolver(policy)
+}
+
+func (csvRows *CSVRows) duplicates() *duplicateResolver {
+	if csvRows.resolver == nil {
+		csvRows.resolver = newDuplicateResolver(DuplicatePolicyFirstWins)
+	}
+
+	return csvRows.resolver
+}
+
+// SetValidator sets validator of rows, only mandatory restrictions are checked by default
+func (csvRows *CSVRows) SetValidator(validator *Validator) {
+	csvRows.validator = validator
+}
+
+func (csvRows *CSVRows) validation() *Validator {
+	return csvRows.validator
+}
+
+func (csvRows *CSVRows) Finish() error {
+	return csvRows.duplicates().finish(csvRows)
+}
+
 // GetRows returns underlying rows collection
 func (csvRows *CSVRows) GetRows() []CSVRow {
 	return csvRows.rows
@@ -84,24 +121,77 @@ func (csvRows *CSVRows) GetDiscardedCnt() int {
 	return csvRows.rowsDiscardedCount
 }
 
-func (csvRows *CSVRows) IncrementDiscardedCnt() {
+func (csvRows *CSVRows) GetDiscardedCntByReason() map[DiscardReason]int {
+	return csvRows.discardedByReason
+}
+
+func (csvRows *CSVRows) AddDiscarded(discard Discard) error {
+	if csvRows.discardedByReason == nil {
+		csvRows.discardedByReason = make(map[DiscardReason]int)
+	}
+	csvRows.discardedByReason[discard.Reason]++
 	csvRows.rowsDiscardedCount++
+
+	return nil
 }
 
 // IsValid checks if row satisfies all restrictions
 func (csvRow *CSVRow) IsValid() bool {
-	if net.ParseIP(csvRow.IPAddress) == nil {
-		return false
+	return len(csvRow.Validate()) == 0
+}
+
+// Validate returns reason of the first violated restriction or empty reason if row is valid
+func (csvRow *CSVRow) Validate() DiscardReason {
+	if _, err := csvRow.Networks(); err != nil {
+		return DiscardReasonInvalidIP
+	}
+	if len(csvRow.City) == 0 {
+		return DiscardReasonMissingCity
 	}
-	if len(csvRow.City) == 0 ||
-		len(csvRow.Country) == 0 ||
-		len(csvRow.CountryCode) == 0 {
-		return false
+	if len(csvRow.Country) == 0 {
+		return DiscardReasonMissingCountry
+	}
+	if len(csvRow.CountryCode) == 0 {
+		return DiscardReasonMissingCountryCode
 	}
 
 	if csvRow.Longitude < -180 || csvRow.Longitude > 180 || csvRow.Latitude < -90 || csvRow.Latitude > 90 {
-		return false
+		return DiscardReasonCoordinatesOutOfRange
+	}
+
+	return ""
+}
+
+// ResolveCountry returns reference country of the row. Country name wins if it disagrees with country code, as the
+// name is what is served, code is used if name is unknown
+func (csvRow *CSVRow) ResolveCountry() (Country, bool) {
+	byCode, codeFound := LookupCountry(csvRow.CountryCode)
+	if codeFound && byCode.MatchesName(csvRow.Country) {
+		return byCode, true
+	}
+	if byName, found := LookupCountryByName(csvRow.Country); found {
+		return byName, true
+	}
+
+	return byCode, codeFound
+}
+
+// Networks returns networks covered by the row
+func (csvRow *CSVRow) Networks() ([]*net.IPNet, error) {
+	return ParseNetworks(csvRow.IPAddress, csvRow.IPAddressEnd)
+}
+
+// networkKeys returns normalized representation of every network covered by the row, so the same network written
+// differently (e.g. 10.0.0.1 and 10.0.0.1/32) is considered duplicate
+func (csvRow *CSVRow) networkKeys() []string {
+	networks, err := csvRow.Networks()
+	if err != nil {
+		return []string{csvRow.IPAddress}
+	}
+	keys := make([]string, 0, len(networks))
+	for _, network := range networks {
+		keys = append(keys, FormatNetwork(network))
 	}
 
-	return true
+	return keys
 }
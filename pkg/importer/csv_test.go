@@ -93,6 +93,7 @@ func TestCSVImporter_Import(t *testing.T) {
 					},
 				},
 				rowsDiscardedCount: 2,
+				discardedByReason:  map[DiscardReason]int{DiscardReasonDecodeError: 2},
 			},
 		},
 		{
@@ -113,53 +114,58 @@ func TestCSVImporter_Import(t *testing.T) {
 			wantErr: false,
 			expected: &CSVRows{
 				rowsDiscardedCount: 2,
+				discardedByReason:  map[DiscardReason]int{DiscardReasonInvalidIP: 2},
 			},
 		},
 		{
 			name: "empty city name",
 			args: args{
 				fileContent: csvHeader + "\n" +
-					"192.184.51.2181,RU,Morocco,,76.7892707471672,-8.617777079132821,2815330924\n",
+					"192.184.51.218,RU,Morocco,,76.7892707471672,-8.617777079132821,2815330924\n",
 			},
 			wantErr: false,
 			expected: &CSVRows{
 				rowsDiscardedCount: 1,
+				discardedByReason:  map[DiscardReason]int{DiscardReasonMissingCity: 1},
 			},
 		},
 		{
 			name: "empty country code",
 			args: args{
 				fileContent: csvHeader + "\n" +
-					"192.184.51.2181,,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n",
+					"192.184.51.218,,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n",
 			},
 			wantErr: false,
 			expected: &CSVRows{
 				rowsDiscardedCount: 1,
+				discardedByReason:  map[DiscardReason]int{DiscardReasonMissingCountryCode: 1},
 			},
 		},
 		{
 			name: "empty country name",
 			args: args{
 				fileContent: csvHeader + "\n" +
-					"192.184.51.2181,RU,,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n",
+					"192.184.51.218,RU,,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n",
 			},
 			wantErr: false,
 			expected: &CSVRows{
 				rowsDiscardedCount: 1,
+				discardedByReason:  map[DiscardReason]int{DiscardReasonMissingCountry: 1},
 			},
 		},
 		{
 			name: "wrong coordinates",
 			args: args{
 				fileContent: csvHeader + "\n" +
-					"192.184.51.2181,RU,Morocco,Willburgh,91,-8.617777079132821,2815330924\n" +
-					"192.184.51.2181,RU,Morocco,Willburgh,-91,-8.617777079132821,2815330924\n" +
-					"192.184.51.2181,RU,Morocco,Willburgh,76.7892707471672,181,2815330924\n" +
-					"192.184.51.2181,RU,Morocco,Willburgh,76.7892707471672,-180.34,2815330924\n",
+					"192.184.51.218,RU,Morocco,Willburgh,91,-8.617777079132821,2815330924\n" +
+					"192.184.51.218,RU,Morocco,Willburgh,-91,-8.617777079132821,2815330924\n" +
+					"192.184.51.218,RU,Morocco,Willburgh,76.7892707471672,181,2815330924\n" +
+					"192.184.51.218,RU,Morocco,Willburgh,76.7892707471672,-180.34,2815330924\n",
 			},
 			wantErr: false,
 			expected: &CSVRows{
 				rowsDiscardedCount: 4,
+				discardedByReason:  map[DiscardReason]int{DiscardReasonCoordinatesOutOfRange: 4},
 			},
 		},
 		{
@@ -183,6 +189,43 @@ func TestCSVImporter_Import(t *testing.T) {
 					},
 				},
 				rowsDiscardedCount: 1,
+				discardedByReason:  map[DiscardReason]int{DiscardReasonConflictingIP: 1},
+			},
+		},
+		{
+			name: "networks and ranges",
+			args: args{
+				fileContent: csvHeader + ",ip_address_end\n" +
+					"10.0.0.0/8,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924,\n" +
+					"10.0.0.0,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924,10.255.255.255\n" +
+					"172.16.0.1,BO,Cuba,Mohamedview,-66.20896958745531,81.62948730878543,8879434387,172.16.0.9\n" +
+					"172.16.0.9,BO,Cuba,Mohamedview,-66.20896958745531,81.62948730878543,8879434387,172.16.0.1\n",
+			},
+			wantErr: false,
+			expected: &CSVRows{
+				rows: []CSVRow{
+					{
+						IPAddress:    "10.0.0.0/8",
+						CountryCode:  "RU",
+						Country:      "Morocco",
+						City:         "Willburgh",
+						Latitude:     76.7892707471672,
+						Longitude:    -8.617777079132821,
+						MysteryValue: "2815330924",
+					},
+					{
+						IPAddress:    "172.16.0.1",
+						IPAddressEnd: "172.16.0.9",
+						CountryCode:  "BO",
+						Country:      "Cuba",
+						City:         "Mohamedview",
+						Latitude:     -66.20896958745531,
+						Longitude:    81.62948730878543,
+						MysteryValue: "8879434387",
+					},
+				},
+				rowsDiscardedCount: 2,
+				discardedByReason:  map[DiscardReason]int{DiscardReasonDuplicateIP: 1, DiscardReasonInvalidIP: 1},
 			},
 		},
 	}
@@ -195,9 +238,12 @@ func TestCSVImporter_Import(t *testing.T) {
 
 			err := CSVImporter.Import(buf, rows)
 			require.Equal(t, tt.wantErr, err != nil)
+			// duplicates bookkeeping isn't part of import result
+			rows.resolver = nil
 			require.Equal(t, tt.expected, rows)
 			require.Equal(t, tt.expected.rows, rows.GetRows())
 			require.Equal(t, tt.expected.rowsDiscardedCount, rows.GetDiscardedCnt())
+			require.Equal(t, tt.expected.discardedByReason, rows.GetDiscardedCntByReason())
 		})
 	}
 }
@@ -0,0 +1,40 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+
+	"github.com/friendsofgo/errors"
+	"github.com/klauspost/compress/zstd"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// Decompress detects gzip or zstd compressed source by its magic bytes and returns decompressed reader, other
+// sources are returned as they are. Detection doesn't rely on file extension, so it works for stdin too
+func Decompress(source io.Reader) (io.ReadCloser, error) {
+	buffered := bufio.NewReader(source)
+	// error is reported by Peek for sources shorter than magic, they are just not compressed
+	header, _ := buffered.Peek(len(zstdMagic))
+	switch {
+	case bytes.HasPrefix(header, gzipMagic):
+		reader, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open gzip")
+		}
+		return reader, nil
+	case bytes.HasPrefix(header, zstdMagic):
+		decoder, err := zstd.NewReader(buffered)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open zstd")
+		}
+		return decoder.IOReadCloser(), nil
+	default:
+		return io.NopCloser(buffered), nil
+	}
+}
@@ -0,0 +1,48 @@
+package importer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecompress(t *testing.T) {
+	content := []byte(csvHeader + "\n" +
+		"192.184.51.218,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n")
+
+	gzipped := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(gzipped)
+	_, err := gzipWriter.Write(content)
+	require.Nil(t, err)
+	require.Nil(t, gzipWriter.Close())
+
+	zstdEncoder, err := zstd.NewWriter(nil)
+	require.Nil(t, err)
+	zstded := zstdEncoder.EncodeAll(content, nil)
+
+	tests := []struct {
+		name     string
+		source   []byte
+		expected []byte
+	}{
+		{name: "plain", source: content, expected: content},
+		{name: "gzip", source: gzipped.Bytes(), expected: content},
+		{name: "zstd", source: zstded, expected: content},
+		{name: "shorter than magic", source: []byte{0x1f}, expected: []byte{0x1f}},
+		{name: "empty", source: []byte{}, expected: []byte{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader, err := Decompress(bytes.NewReader(tt.source))
+			require.Nil(t, err)
+			defer reader.Close()
+			decompressed, err := io.ReadAll(reader)
+			require.Nil(t, err)
+			require.Equal(t, tt.expected, decompressed)
+		})
+	}
+}
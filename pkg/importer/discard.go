@@ -0,0 +1,92 @@
+package importer
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/friendsofgo/errors"
+)
+
+// DiscardReason explains why source row was discarded during import
+type DiscardReason string
+
+const (
+	DiscardReasonDecodeError           DiscardReason = "decode_error"
+	DiscardReasonInvalidIP             DiscardReason = "invalid_ip"
+	DiscardReasonMissingCity           DiscardReason = "missing_city"
+	DiscardReasonMissingCountry        DiscardReason = "missing_country"
+	DiscardReasonMissingCountryCode    DiscardReason = "missing_country_code"
+	DiscardReasonCoordinatesOutOfRange DiscardReason = "coordinates_out_of_range"
+	// DiscardReasonDuplicateIP is exact duplicate of other row of the network
+	DiscardReasonDuplicateIP DiscardReason = "duplicate_ip"
+	// DiscardReasonConflictingIP is row of the network which differs from other one, see DuplicatePolicy
+	DiscardReasonConflictingIP DiscardReason = "conflicting_ip"
+
+	// reasons of optional validation rules, see Validator
+	DiscardReasonInvalidCountryCode  DiscardReason = "invalid_country_code"
+	DiscardReasonCountryMismatch     DiscardReason = "country_mismatch"
+	DiscardReasonInvalidMysteryValue DiscardReason = "invalid_mystery_value"
+	DiscardReasonInvalidCharacters   DiscardReason = "invalid_characters"
+)
+
+// Discard describes source row discarded during import
+type Discard struct {
+	// Line is line number of the row in source, starting from 1
+	Line   int           `json:"line"`
+	Reason DiscardReason `json:"reason"`
+	// Raw is original row as it is written in source
+	Raw string `json:"row"`
+	// Source is name of source containing the row, e.g. file path
+	Source string `json:"source"`
+}
+
+// RejectedWriter writes discarded rows as csv with line, reason, raw row and source columns
+type RejectedWriter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func NewRejectedWriter(writer io.Writer) *RejectedWriter {
+	return &RejectedWriter{writer: csv.NewWriter(writer)}
+}
+
+// Write writes discarded row, header is written before the first one
+func (rejectedWriter *RejectedWriter) Write(discard Discard) error {
+	if !rejectedWriter.headerWritten {
+		err := rejectedWriter.writer.Write([]string{"line", "reason", "row", "source"})
+		if err != nil {
+			return errors.Wrap(err, "failed to write rejected rows header")
+		}
+		rejectedWriter.headerWritten = true
+	}
+	err := rejectedWriter.writer.Write([]string{
+		strconv.Itoa(discard.Line),
+		string(discard.Reason),
+		discard.Raw,
+		discard.Source,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to write rejected row")
+	}
+
+	return nil
+}
+
+// Flush writes buffered rows to the underlying writer
+func (rejectedWriter *RejectedWriter) Flush() error {
+	rejectedWriter.writer.Flush()
+
+	return rejectedWriter.writer.Error()
+}
+
+// joinCSVRecord encodes record back into csv line without trailing new line
+func joinCSVRecord(record []string) string {
+	builder := &strings.Builder{}
+	writer := csv.NewWriter(builder)
+	_ = writer.Write(record)
+	writer.Flush()
+
+	return strings.TrimSuffix(builder.String(), "\n")
+}
@@ -0,0 +1,303 @@
+package importer
+
+import (
+	"hash/fnv"
+	"math"
+	"strconv"
+
+	"github.com/friendsofgo/errors"
+)
+
+// DuplicatePolicy decides which of valid rows with the same networks are imported. Rows are duplicates if they cover
+// the same networks and all their other fields are equal, otherwise they conflict. Rows which share only some of
+// their networks, e.g. range and CIDR network inside it, always conflict
+type DuplicatePolicy string
+
+const (
+	// DuplicatePolicyFirstWins keeps the first row of network and discards later ones
+	DuplicatePolicyFirstWins DuplicatePolicy = "first-wins"
+	// DuplicatePolicyLastWins keeps the last row of network and discards earlier ones
+	DuplicatePolicyLastWins DuplicatePolicy = "last-wins"
+	// DuplicatePolicyRejectAllConflicting drops network entirely if its rows conflict, duplicates are kept once
+	DuplicatePolicyRejectAllConflicting DuplicatePolicy = "reject-all-conflicting"
+	// DuplicatePolicyKeepIdenticalOnly keeps duplicates once and fails import on the first conflict
+	DuplicatePolicyKeepIdenticalOnly DuplicatePolicy = "keep-identical-only"
+)
+
+var ErrConflictingRows = errors.New("conflicting rows")
+
+// ParseDuplicatePolicy validates policy name
+func ParseDuplicatePolicy(policy string) (DuplicatePolicy, error) {
+	switch DuplicatePolicy(policy) {
+	case DuplicatePolicyFirstWins,
+		DuplicatePolicyLastWins,
+		DuplicatePolicyRejectAllConflicting,
+		DuplicatePolicyKeepIdenticalOnly:
+		return DuplicatePolicy(policy), nil
+	default:
+		return "", errors.Errorf("unknown duplicate policy %q", policy)
+	}
+}
+
+// isBuffered reports if policy decision on row depends on later rows, so rows are held until all sources are
+// imported
+func (policy DuplicatePolicy) isBuffered() bool {
+	return policy == DuplicatePolicyLastWins || policy == DuplicatePolicyRejectAllConflicting
+}
+
+// duplicateResolver applies duplicate policy to valid rows before they are added to rows. It keeps every distinct
+// network in memory and buffered policies also hold every row, so its memory grows with imported rows
+type duplicateResolver struct {
+	policy DuplicatePolicy
+	// source is name of source being imported, rows held by buffered policy remember it for discarding
+	source string
+	// added rows by network key, used by not buffered policies only
+	added map[string]*addedRow
+	// pending rows held by buffered policy in source order, dropped rows are nil
+	pending []*pendingRow
+	// pendingByKey is index of network row in pending, it points to nil for rejected network
+	pendingByKey map[string]int
+}
+
+type addedRow struct {
+	fingerprint uint64
+	networks    int
+}
+
+type pendingRow struct {
+	row         CSVRow
+	fingerprint uint64
+	// keys are network keys of the row
+	keys []string
+	// position is discard of the row if it is dropped later, reason is set then
+	position Discard
+}
+
+func newDuplicateResolver(policy DuplicatePolicy) *duplicateResolver {
+	if len(policy) == 0 {
+		policy = DuplicatePolicyFirstWins
+	}
+
+	return &duplicateResolver{
+		policy:       policy,
+		added:        make(map[string]*addedRow),
+		pendingByKey: make(map[string]int),
+	}
+}
+
+// resolve adds valid row to rows or discards it according to policy
+func (resolver *duplicateResolver) resolve(rows ImportedRows, row CSVRow, position func() (int, string)) error {
+	if resolver.policy.isBuffered() {
+		line, raw := position()
+		return resolver.resolveBuffered(rows, &pendingRow{
+			row:         row,
+			fingerprint: rowFingerprint(row),
+			keys:        row.networkKeys(),
+			position:    Discard{Line: line, Raw: raw, Source: resolver.source},
+		})
+	}
+
+	keys := row.networkKeys()
+	fingerprint := rowFingerprint(row)
+	first, overlaps := resolver.addedRowOf(keys)
+	if !overlaps {
+		added := &addedRow{fingerprint: fingerprint, networks: len(keys)}
+		for _, key := range keys {
+			resolver.added[key] = added
+		}
+		return errors.Wrap(rows.addRow(row), "failed to add row")
+	}
+	reason := DiscardReasonConflictingIP
+	if first != nil {
+		reason = duplicateReason(first.fingerprint, fingerprint)
+	}
+	line, raw := position()
+	if reason == DiscardReasonConflictingIP && resolver.policy == DuplicatePolicyKeepIdenticalOnly {
+		return errors.Wrapf(ErrConflictingRows, "%s at line %d", row.IPAddress, line)
+	}
+
+	return discard(rows, Discard{Line: line, Reason: reason, Raw: raw})
+}
+
+// addedRowOf returns added row covering exactly the same networks as keys. overlaps is set if any of networks is
+// added, added row is nil then if networks overlap only partially
+func (resolver *duplicateResolver) addedRowOf(keys []string) (added *addedRow, overlaps bool) {
+	for i, key := range keys {
+		row, exists := resolver.added[key]
+		if exists {
+			overlaps = true
+		}
+		if i == 0 {
+			added = row
+		}
+		if row != added {
+			return nil, overlaps
+		}
+	}
+	if added != nil && added.networks != len(keys) {
+		return nil, true
+	}
+
+	return added, overlaps
+}
+
+func (resolver *duplicateResolver) resolveBuffered(rows ImportedRows, current *pendingRow) error {
+	indexes, covered := resolver.pendingIndexes(current.keys)
+	if len(indexes) == 0 {
+		resolver.hold(current)
+		return nil
+	}
+	if len(indexes) == 1 && covered {
+		kept := resolver.pending[indexes[0]]
+		if kept != nil && len(kept.keys) == len(current.keys) {
+			return resolver.resolveSameNetworks(rows, indexes[0], current)
+		}
+	}
+
+	// networks overlap partially or some of them are already rejected
+	if resolver.policy == DuplicatePolicyLastWins {
+		for _, index := range indexes {
+			err := resolver.drop(rows, index, DiscardReasonConflictingIP, true)
+			if err != nil {
+				return err
+			}
+		}
+		resolver.hold(current)
+		return nil
+	}
+	for _, index := range indexes {
+		err := resolver.drop(rows, index, DiscardReasonConflictingIP, false)
+		if err != nil {
+			return err
+		}
+	}
+	for _, key := range current.keys {
+		resolver.pendingByKey[key] = len(resolver.pending)
+	}
+	resolver.pending = append(resolver.pending, nil)
+	current.position.Reason = DiscardReasonConflictingIP
+
+	return discard(rows, current.position)
+}
+
+// resolveSameNetworks resolves row covering the same networks as pending row of index
+func (resolver *duplicateResolver) resolveSameNetworks(rows ImportedRows, index int, current *pendingRow) error {
+	kept := resolver.pending[index]
+	reason := duplicateReason(kept.fingerprint, current.fingerprint)
+
+	switch {
+	case resolver.policy == DuplicatePolicyLastWins:
+		err := resolver.drop(rows, index, reason, false)
+		if err != nil {
+			return err
+		}
+		resolver.hold(current)
+		return nil
+	case reason == DiscardReasonDuplicateIP:
+		current.position.Reason = reason
+		return discard(rows, current.position)
+	default:
+		current.position.Reason = reason
+		err := resolver.drop(rows, index, reason, false)
+		if err != nil {
+			return err
+		}
+		return discard(rows, current.position)
+	}
+}
+
+// pendingIndexes returns distinct indexes of pending rows covering any of networks, covered is set if every network
+// is covered
+func (resolver *duplicateResolver) pendingIndexes(keys []string) (indexes []int, covered bool) {
+	covered = true
+	seen := make(map[int]struct{})
+	for _, key := range keys {
+		index, exists := resolver.pendingByKey[key]
+		if !exists {
+			covered = false
+			continue
+		}
+		if _, duplicate := seen[index]; duplicate {
+			continue
+		}
+		seen[index] = struct{}{}
+		indexes = append(indexes, index)
+	}
+
+	return indexes, covered
+}
+
+// hold appends row to pending rows, its networks refer to it
+func (resolver *duplicateResolver) hold(row *pendingRow) {
+	for _, key := range row.keys {
+		resolver.pendingByKey[key] = len(resolver.pending)
+	}
+	resolver.pending = append(resolver.pending, row)
+}
+
+// drop discards pending row of index if it isn't dropped yet. Its networks are released if release is set, otherwise
+// they stay rejected
+func (resolver *duplicateResolver) drop(rows ImportedRows, index int, reason DiscardReason, release bool) error {
+	kept := resolver.pending[index]
+	if kept == nil {
+		return nil
+	}
+	resolver.pending[index] = nil
+	if release {
+		for _, key := range kept.keys {
+			if resolver.pendingByKey[key] == index {
+				delete(resolver.pendingByKey, key)
+			}
+		}
+	}
+	kept.position.Reason = reason
+
+	return discard(rows, kept.position)
+}
+
+// finish adds rows held by buffered policy to rows in source order
+func (resolver *duplicateResolver) finish(rows ImportedRows) error {
+	for _, pending := range resolver.pending {
+		if pending == nil {
+			continue
+		}
+		err := rows.addRow(pending.row)
+		if err != nil {
+			return errors.Wrap(err, "failed to add row")
+		}
+	}
+	resolver.pending = nil
+	resolver.pendingByKey = make(map[string]int)
+
+	return nil
+}
+
+func discard(rows ImportedRows, discard Discard) error {
+	return errors.Wrap(rows.AddDiscarded(discard), "failed to discard row")
+}
+
+func duplicateReason(first, fingerprint uint64) DiscardReason {
+	if first == fingerprint {
+		return DiscardReasonDuplicateIP
+	}
+
+	return DiscardReasonConflictingIP
+}
+
+// rowFingerprint hashes row fields except IP address, which is compared by network key
+func rowFingerprint(row CSVRow) uint64 {
+	hash := fnv.New64a()
+	for _, field := range []string{
+		row.CountryCode,
+		row.Country,
+		row.City,
+		strconv.FormatUint(math.Float64bits(row.Latitude), 16),
+		strconv.FormatUint(math.Float64bits(row.Longitude), 16),
+		row.MysteryValue,
+	} {
+		_, _ = hash.Write([]byte(field))
+		_, _ = hash.Write([]byte{0})
+	}
+
+	return hash.Sum64()
+}
@@ -0,0 +1,194 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDuplicatePolicy(t *testing.T) {
+	fileContent := csvHeader + "\n" +
+		"10.0.0.1,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n" +
+		"10.0.0.2,BO,Cuba,Mohamedview,-66.20896958745531,81.62948730878543,8879434387\n" +
+		"10.0.0.1/32,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n" +
+		"10.0.0.3,SK,Slovakia,Mrazview,48.82685320435576,2.9300655090904684,0\n" +
+		"10.0.0.1,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,1111111111\n" +
+		"10.0.0.3,SK,Slovakia,Mrazview,48.82685320435576,2.9300655090904684,0\n"
+
+	tests := []struct {
+		name              string
+		policy            DuplicatePolicy
+		wantErr           error
+		expectedRows      []string
+		expectedMystery   string
+		discardedByReason map[DiscardReason]int
+	}{
+		{
+			name:              "first wins",
+			policy:            DuplicatePolicyFirstWins,
+			expectedRows:      []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"},
+			expectedMystery:   "2815330924",
+			discardedByReason: map[DiscardReason]int{DiscardReasonDuplicateIP: 2, DiscardReasonConflictingIP: 1},
+		},
+		{
+			name:              "last wins",
+			policy:            DuplicatePolicyLastWins,
+			expectedRows:      []string{"10.0.0.2", "10.0.0.1", "10.0.0.3"},
+			expectedMystery:   "1111111111",
+			discardedByReason: map[DiscardReason]int{DiscardReasonDuplicateIP: 2, DiscardReasonConflictingIP: 1},
+		},
+		{
+			name:              "reject all conflicting",
+			policy:            DuplicatePolicyRejectAllConflicting,
+			expectedRows:      []string{"10.0.0.2", "10.0.0.3"},
+			discardedByReason: map[DiscardReason]int{DiscardReasonDuplicateIP: 2, DiscardReasonConflictingIP: 2},
+		},
+		{
+			name:    "keep identical only",
+			policy:  DuplicatePolicyKeepIdenticalOnly,
+			wantErr: ErrConflictingRows,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows := &CSVRows{}
+			rows.SetDuplicatePolicy(tt.policy)
+
+			err := GetCSVImporter().Import(bytes.NewBufferString(fileContent), rows)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.Nil(t, err)
+			require.Nil(t, rows.Finish())
+			var ips []string
+			for _, row := range rows.GetRows() {
+				ips = append(ips, row.IPAddress)
+				if row.IPAddress == "10.0.0.1" || row.IPAddress == "10.0.0.1/32" {
+					require.Equal(t, tt.expectedMystery, row.MysteryValue)
+				}
+			}
+			require.Equal(t, tt.expectedRows, ips)
+			require.Equal(t, tt.discardedByReason, rows.GetDiscardedCntByReason())
+		})
+	}
+}
+
+func TestDuplicatePolicy_PartiallyOverlapping(t *testing.T) {
+	// range covers 10.1.0.0/30 and 10.1.0.4/31, rows sharing only some of its networks conflict with it
+	fileContent := "ip_address,ip_address_end,country_code,country,city,latitude,longitude,mystery_value\n" +
+		"10.1.0.0,10.1.0.5,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n" +
+		"10.1.0.4/31,,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n" +
+		"10.2.0.0,,BO,Cuba,Mohamedview,-66.20896958745531,81.62948730878543,8879434387\n" +
+		"10.1.0.0/30,,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n"
+
+	tests := []struct {
+		name         string
+		policy       DuplicatePolicy
+		wantErr      error
+		expectedRows []string
+		conflicting  int
+	}{
+		{
+			name:         "first wins",
+			policy:       DuplicatePolicyFirstWins,
+			expectedRows: []string{"10.1.0.0", "10.2.0.0"},
+			conflicting:  2,
+		},
+		{
+			name:         "last wins releases networks of dropped row",
+			policy:       DuplicatePolicyLastWins,
+			expectedRows: []string{"10.1.0.4/31", "10.2.0.0", "10.1.0.0/30"},
+			conflicting:  1,
+		},
+		{
+			name:         "reject all conflicting",
+			policy:       DuplicatePolicyRejectAllConflicting,
+			expectedRows: []string{"10.2.0.0"},
+			conflicting:  3,
+		},
+		{
+			name:    "keep identical only",
+			policy:  DuplicatePolicyKeepIdenticalOnly,
+			wantErr: ErrConflictingRows,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows := &CSVRows{}
+			rows.SetDuplicatePolicy(tt.policy)
+
+			err := GetCSVImporter().Import(bytes.NewBufferString(fileContent), rows)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.Nil(t, err)
+			require.Nil(t, rows.Finish())
+			var ips []string
+			networks := make(map[string]struct{})
+			for _, row := range rows.GetRows() {
+				ips = append(ips, row.IPAddress)
+				for _, key := range row.networkKeys() {
+					require.NotContains(t, networks, key)
+					networks[key] = struct{}{}
+				}
+			}
+			require.Equal(t, tt.expectedRows, ips)
+			require.Equal(t, map[DiscardReason]int{DiscardReasonConflictingIP: tt.conflicting}, rows.GetDiscardedCntByReason())
+		})
+	}
+}
+
+func TestDuplicatePolicy_KeepIdenticalOnly(t *testing.T) {
+	fileContent := csvHeader + "\n" +
+		"10.0.0.1,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n" +
+		"10.0.0.1/32,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n"
+
+	rows := &CSVRows{}
+	rows.SetDuplicatePolicy(DuplicatePolicyKeepIdenticalOnly)
+
+	require.Nil(t, GetCSVImporter().Import(bytes.NewBufferString(fileContent), rows))
+	require.Nil(t, rows.Finish())
+	require.Len(t, rows.GetRows(), 1)
+	require.Equal(t, map[DiscardReason]int{DiscardReasonDuplicateIP: 1}, rows.GetDiscardedCntByReason())
+}
+
+func TestDuplicatePolicy_BufferedAcrossSources(t *testing.T) {
+	stream := NewCSVRowsStream(context.Background(), 10, 2)
+	stream.SetDuplicatePolicy(DuplicatePolicyRejectAllConflicting)
+	rejected := &bytes.Buffer{}
+	stream.SetRejectedWriter(NewRejectedWriter(rejected))
+	sources := map[string]string{
+		"first.csv": csvHeader + "\n" +
+			"192.184.51.218,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n",
+		"second.csv": csvHeader + "\n" +
+			"160.168.85.54,BO,Cuba,Mohamedview,-66.20896958745531,81.62948730878543,8879434387\n" +
+			"192.184.51.218,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,0\n",
+	}
+	for _, name := range []string{"first.csv", "second.csv"} {
+		stream.SetSource(name)
+		require.Nil(t, GetCSVImporter().Import(bytes.NewBufferString(sources[name]), stream))
+	}
+	require.Equal(t, 0, stream.GetAcceptedCnt())
+	require.Nil(t, stream.Finish())
+	stream.Close()
+
+	var ips []string
+	for batch := range stream.Batches() {
+		for _, row := range batch {
+			ips = append(ips, row.IPAddress)
+		}
+	}
+	require.Equal(t, []string{"160.168.85.54"}, ips)
+	require.Nil(t, stream.rejectedWriter.Flush())
+	require.Equal(t, "line,reason,row,source\n"+
+		"2,conflicting_ip,\"192.184.51.218,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\","+
+		"first.csv\n"+
+		"3,conflicting_ip,\"192.184.51.218,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,0\","+
+		"second.csv\n",
+		rejected.String(),
+	)
+}
@@ -7,14 +7,39 @@ import (
 type ImportedRows interface {
 	// GetDiscardedCnt returns count of discarded rows during import
 	GetDiscardedCnt() int
-	// IncrementDiscardedCnt increases internal counter of discarded rows during import by one
-	IncrementDiscardedCnt()
+	// GetDiscardedCntByReason returns count of discarded rows during import by discard reason
+	GetDiscardedCntByReason() map[DiscardReason]int
+	// AddDiscarded registers row discarded during import
+	AddDiscarded(discard Discard) error
+
+	// Finish adds rows held by duplicate policy, should be called once all sources are imported into rows
+	Finish() error
 
 	// addRow appends row to underlying rows collection
 	addRow(interface{}) error
+
+	// duplicates returns duplicate resolver shared by all sources imported into rows
+	duplicates() *duplicateResolver
+	// validation returns validator of rows, nil validator checks mandatory restrictions only
+	validation() *Validator
 }
 
 type Importer interface {
 	// Import imports data from source to provided rows
 	Import(source io.Reader, rows ImportedRows) error
 }
+
+// collectRow passes row valid by rows validator to rows according to their duplicate policy, invalid row is
+// discarded. Reason is set if row is already known to be invalid, e.g. it couldn't be decoded. position returns line
+// number and raw row, it is called only if it is needed
+func collectRow(rows ImportedRows, row CSVRow, reason DiscardReason, position func() (int, string)) error {
+	if len(reason) == 0 {
+		reason = rows.validation().Validate(&row)
+	}
+	if len(reason) != 0 {
+		line, raw := position()
+		return discard(rows, Discard{Line: line, Reason: reason, Raw: raw})
+	}
+
+	return rows.duplicates().resolve(rows, row, position)
+}
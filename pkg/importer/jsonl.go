@@ -0,0 +1,102 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/friendsofgo/errors"
+)
+
+// JSONLImporter imports JSON Lines (NDJSON), every line is json object with CSVRow fields, coordinates are numbers,
+// mystery_value is either string or number
+type JSONLImporter struct{}
+
+// jsonlRow is json line as it is decoded, required fields are pointers to tell missing field from zero value
+type jsonlRow struct {
+	IPAddress    *string      `json:"ip_address"`
+	IPAddressEnd string       `json:"ip_address_end"`
+	CountryCode  *string      `json:"country_code"`
+	Country      *string      `json:"country"`
+	City         *string      `json:"city"`
+	Latitude     *float64     `json:"latitude"`
+	Longitude    *float64     `json:"longitude"`
+	MysteryValue mysteryValue `json:"mystery_value"`
+}
+
+// mysteryValue is mystery_value of json line, it may be either string or number which is kept as it is written
+type mysteryValue string
+
+func (value *mysteryValue) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if len(data) != 0 && data[0] == '"' {
+		return json.Unmarshal(data, (*string)(value))
+	}
+	number := json.Number("")
+	if err := json.Unmarshal(data, &number); err != nil {
+		return errors.Wrap(err, "mystery_value must be string or number")
+	}
+	*value = mysteryValue(number)
+
+	return nil
+}
+
+// csvRow returns row of json line, it fails if any required field is missing or null
+func (row jsonlRow) csvRow() (CSVRow, bool) {
+	if row.IPAddress == nil || row.CountryCode == nil || row.Country == nil || row.City == nil ||
+		row.Latitude == nil || row.Longitude == nil {
+		return CSVRow{}, false
+	}
+
+	return CSVRow{
+		IPAddress:    *row.IPAddress,
+		IPAddressEnd: row.IPAddressEnd,
+		CountryCode:  *row.CountryCode,
+		Country:      *row.Country,
+		City:         *row.City,
+		Latitude:     *row.Latitude,
+		Longitude:    *row.Longitude,
+		MysteryValue: string(row.MysteryValue),
+	}, true
+}
+
+func GetJSONLImporter() Importer {
+	return &JSONLImporter{}
+}
+
+// Import imports json lines from source to provided rows. Lines which aren't valid json objects of expected types or
+// miss required fields are discarded, empty lines are skipped
+func (jsonlImporter *JSONLImporter) Import(source io.Reader, rows ImportedRows) error {
+	// lines are read without scanner token size limit
+	reader := bufio.NewReader(source)
+	for lineNumber := 1; ; lineNumber++ {
+		line, readErr := reader.ReadBytes('\n')
+		if readErr != nil && readErr != io.EOF {
+			return errors.Wrap(readErr, "failed to read line")
+		}
+		line = bytes.TrimSpace(line)
+		if len(line) != 0 {
+			decoded := jsonlRow{}
+			reason := DiscardReason("")
+			row, complete := CSVRow{}, false
+			if json.Unmarshal(line, &decoded) == nil {
+				row, complete = decoded.csvRow()
+			}
+			if !complete {
+				reason = DiscardReasonDecodeError
+			}
+			err := collectRow(rows, row, reason, func() (int, string) {
+				return lineNumber, string(line)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if readErr == io.EOF {
+			return nil
+		}
+	}
+}
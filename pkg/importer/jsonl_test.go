@@ -0,0 +1,181 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONLImporter_Import(t *testing.T) {
+	type args struct {
+		fileContent string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantErr  bool
+		expected *CSVRows
+	}{
+		{
+			name: "all success",
+			args: args{
+				fileContent: `{"ip_address":"192.184.51.218","country_code":"RU","country":"Morocco","city":"Willburgh",` +
+					`"latitude":76.7892707471672,"longitude":-8.617777079132821,"mystery_value":"2815330924"}` + "\n" +
+					"\n" +
+					`{"ip_address":"10.0.0.0","ip_address_end":"10.0.0.255","country_code":"BO","country":"Cuba",` +
+					`"city":"Mohamedview","latitude":-66.20896958745531,"longitude":81.62948730878543}`,
+			},
+			wantErr: false,
+			expected: &CSVRows{
+				rows: []CSVRow{
+					{
+						IPAddress:    "192.184.51.218",
+						CountryCode:  "RU",
+						Country:      "Morocco",
+						City:         "Willburgh",
+						Latitude:     76.7892707471672,
+						Longitude:    -8.617777079132821,
+						MysteryValue: "2815330924",
+					},
+					{
+						IPAddress:    "10.0.0.0",
+						IPAddressEnd: "10.0.0.255",
+						CountryCode:  "BO",
+						Country:      "Cuba",
+						City:         "Mohamedview",
+						Latitude:     -66.20896958745531,
+						Longitude:    81.62948730878543,
+					},
+				},
+			},
+		},
+		{
+			name: "numeric mystery value is kept as it is written",
+			args: args{
+				fileContent: `{"ip_address":"192.184.51.218","country_code":"RU","country":"Morocco","city":"Willburgh",` +
+					`"latitude":76.7892707471672,"longitude":-8.617777079132821,"mystery_value":2815330924}` + "\n" +
+					`{"ip_address":"192.184.51.219","country_code":"RU","country":"Morocco","city":"Willburgh",` +
+					`"latitude":76.7892707471672,"longitude":-8.617777079132821,"mystery_value":1.50}` + "\n" +
+					`{"ip_address":"192.184.51.220","country_code":"RU","country":"Morocco","city":"Willburgh",` +
+					`"latitude":76.7892707471672,"longitude":-8.617777079132821,"mystery_value":true}` + "\n",
+			},
+			wantErr: false,
+			expected: &CSVRows{
+				rows: []CSVRow{
+					{
+						IPAddress:    "192.184.51.218",
+						CountryCode:  "RU",
+						Country:      "Morocco",
+						City:         "Willburgh",
+						Latitude:     76.7892707471672,
+						Longitude:    -8.617777079132821,
+						MysteryValue: "2815330924",
+					},
+					{
+						IPAddress:    "192.184.51.219",
+						CountryCode:  "RU",
+						Country:      "Morocco",
+						City:         "Willburgh",
+						Latitude:     76.7892707471672,
+						Longitude:    -8.617777079132821,
+						MysteryValue: "1.50",
+					},
+				},
+				rowsDiscardedCount: 1,
+				discardedByReason:  map[DiscardReason]int{DiscardReasonDecodeError: 1},
+			},
+		},
+		{
+			name: "decode errors, invalid and duplicate rows are discarded",
+			args: args{
+				fileContent: `{"ip_address":"192.184.51.218","country_code":"RU","country":"Morocco","city":"Willburgh",` +
+					`"latitude":76.7892707471672,"longitude":-8.617777079132821}` + "\n" +
+					`{"ip_address":"160.168.85.54","country_code":"BO","country":"Cuba","city":"Mohamedview",` +
+					`"latitude":"-66.2","longitude":81.6}` + "\n" +
+					`not json` + "\n" +
+					`["192.184.51.218"]` + "\n" +
+					`{"ip_address":"192.184.51","country_code":"RU","country":"Morocco","city":"Willburgh",` +
+					`"latitude":0,"longitude":0}` + "\n" +
+					`{"ip_address":"192.184.51.218/32","country_code":"RU","country":"Morocco","city":"Willburgh",` +
+					`"latitude":0,"longitude":0}` + "\n" +
+					`{"ip_address":"160.168.85.54","country_code":"BO","country":"Cuba","city":"Mohamedview",` +
+					`"latitude":100,"longitude":0}` + "\n",
+			},
+			wantErr: false,
+			expected: &CSVRows{
+				rows: []CSVRow{
+					{
+						IPAddress:   "192.184.51.218",
+						CountryCode: "RU",
+						Country:     "Morocco",
+						City:        "Willburgh",
+						Latitude:    76.7892707471672,
+						Longitude:   -8.617777079132821,
+					},
+				},
+				rowsDiscardedCount: 6,
+				discardedByReason: map[DiscardReason]int{
+					DiscardReasonDecodeError:           3,
+					DiscardReasonInvalidIP:             1,
+					DiscardReasonConflictingIP:         1,
+					DiscardReasonCoordinatesOutOfRange: 1,
+				},
+			},
+		},
+		{
+			name: "rows with missing required fields are decode errors",
+			args: args{
+				fileContent: `{"ip_address":"192.184.51.218","country_code":"RU","country":"Morocco","city":"Willburgh",` +
+					`"longitude":-8.617777079132821}` + "\n" +
+					`{"ip_address":"192.184.51.219","country_code":"RU","country":"Morocco","city":"Willburgh",` +
+					`"latitude":null,"longitude":-8.617777079132821}` + "\n" +
+					`{"ip_address":"192.184.51.220","country_code":"RU","country":"Morocco","latitude":0,"longitude":0}` +
+					"\n" +
+					`{"country_code":"RU","country":"Morocco","city":"Willburgh","latitude":0,"longitude":0}` + "\n" +
+					// empty values are present, they are discarded by sanitisation like empty csv values
+					`{"ip_address":"192.184.51.221","country_code":"RU","country":"Morocco","city":"",` +
+					`"latitude":0,"longitude":0}` + "\n",
+			},
+			wantErr: false,
+			expected: &CSVRows{
+				rowsDiscardedCount: 5,
+				discardedByReason: map[DiscardReason]int{
+					DiscardReasonDecodeError: 4,
+					DiscardReasonMissingCity: 1,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			JSONLImporter := &JSONLImporter{}
+			rows := &CSVRows{}
+			buf := &bytes.Buffer{}
+			buf.WriteString(tt.args.fileContent)
+
+			err := JSONLImporter.Import(buf, rows)
+			require.Equal(t, tt.wantErr, err != nil)
+			// duplicates bookkeeping isn't part of import result
+			rows.resolver = nil
+			require.Equal(t, tt.expected, rows)
+			require.Equal(t, tt.expected.rows, rows.GetRows())
+			require.Equal(t, tt.expected.rowsDiscardedCount, rows.GetDiscardedCnt())
+			require.Equal(t, tt.expected.discardedByReason, rows.GetDiscardedCntByReason())
+		})
+	}
+}
+
+func TestJSONLImporter_RejectedLines(t *testing.T) {
+	rejected := &bytes.Buffer{}
+	stream := NewCSVRowsStream(context.Background(), 10, 1)
+	stream.SetRejectedWriter(NewRejectedWriter(rejected))
+
+	err := GetJSONLImporter().Import(bytes.NewBufferString("\n\nnot json\n"), stream)
+	require.Nil(t, err)
+	stream.Close()
+	require.Equal(t, 1, stream.GetDiscardedCnt())
+	require.Nil(t, stream.rejectedWriter.Flush())
+	require.Equal(t, "line,reason,row,source\n3,decode_error,not json,\n", rejected.String())
+}
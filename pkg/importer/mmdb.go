@@ -0,0 +1,78 @@
+package importer
+
+import (
+	"io"
+
+	"github.com/friendsofgo/errors"
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// MMDBLanguage is language of city and country names read from and written to MaxMind DB
+const MMDBLanguage = "en"
+
+// MMDBRecord is subset of GeoIP2 City record layout, MysteryValue is custom field
+type MMDBRecord struct {
+	City struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"city"`
+	Country struct {
+		ISOCode string            `maxminddb:"iso_code"`
+		Names   map[string]string `maxminddb:"names"`
+	} `maxminddb:"country"`
+	Location struct {
+		Latitude  float64 `maxminddb:"latitude"`
+		Longitude float64 `maxminddb:"longitude"`
+	} `maxminddb:"location"`
+	MysteryValue string `maxminddb:"mystery_value"`
+}
+
+type MMDBImporter struct{}
+
+func GetMMDBImporter() Importer {
+	return &MMDBImporter{}
+}
+
+// Import imports every network of MaxMind DB from source to provided rows. MaxMind DB has no lines, so discarded
+// rows are numbered by network order starting from 1. Whole source is read into memory, as db format requires
+// random access
+func (mmdbImporter *MMDBImporter) Import(source io.Reader, rows ImportedRows) error {
+	buffer, err := io.ReadAll(source)
+	if err != nil {
+		return errors.Wrap(err, "failed to read mmdb")
+	}
+	reader, err := maxminddb.FromBytes(buffer)
+	if err != nil {
+		return errors.Wrap(err, "failed to open mmdb")
+	}
+
+	networks := reader.Networks(maxminddb.SkipAliasedNetworks)
+	for number := 1; networks.Next(); number++ {
+		record := MMDBRecord{}
+		network, err := networks.Network(&record)
+		if err != nil {
+			// network isn't returned for record which can't be decoded
+			err = rows.AddDiscarded(Discard{Line: number, Reason: DiscardReasonDecodeError})
+			if err != nil {
+				return errors.Wrap(err, "failed to discard row")
+			}
+			continue
+		}
+		row := CSVRow{
+			IPAddress:    FormatNetwork(network),
+			CountryCode:  record.Country.ISOCode,
+			Country:      record.Country.Names[MMDBLanguage],
+			City:         record.City.Names[MMDBLanguage],
+			Latitude:     record.Location.Latitude,
+			Longitude:    record.Location.Longitude,
+			MysteryValue: record.MysteryValue,
+		}
+		err = collectRow(rows, row, "", func() (int, string) {
+			return number, row.IPAddress
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return errors.Wrap(networks.Err(), "failed to read mmdb networks")
+}
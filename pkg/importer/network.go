@@ -0,0 +1,87 @@
+package importer
+
+import (
+	"math/big"
+	"net"
+	"strings"
+
+	"github.com/friendsofgo/errors"
+)
+
+// ParseNetworks parses address column of the row into networks. Address could be single IP (treated as /32 or
+// /128 network) or CIDR. If end is not empty, address and end are treated as inclusive IP range which is split
+// into minimal set of CIDR networks
+func ParseNetworks(address, end string) ([]*net.IPNet, error) {
+	if len(end) != 0 {
+		return rangeToNetworks(net.ParseIP(address), net.ParseIP(end))
+	}
+	if strings.Contains(address, "/") {
+		_, network, err := net.ParseCIDR(address)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid network")
+		}
+		return []*net.IPNet{network}, nil
+	}
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return nil, errors.New("invalid IP address")
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	bits := len(ip) * 8
+
+	return []*net.IPNet{{IP: ip, Mask: net.CIDRMask(bits, bits)}}, nil
+}
+
+// FormatNetwork returns plain IP for single host networks and CIDR notation otherwise
+func FormatNetwork(network *net.IPNet) string {
+	ones, bits := network.Mask.Size()
+	if ones == bits {
+		return network.IP.String()
+	}
+
+	return network.String()
+}
+
+func rangeToNetworks(start, end net.IP) ([]*net.IPNet, error) {
+	if start == nil || end == nil {
+		return nil, errors.New("invalid IP range")
+	}
+	if start4, end4 := start.To4(), end.To4(); start4 != nil && end4 != nil {
+		start, end = start4, end4
+	} else if start4 != nil || end4 != nil {
+		return nil, errors.New("IP range boundaries belong to different families")
+	}
+	bits := len(start) * 8
+	current := new(big.Int).SetBytes(start)
+	last := new(big.Int).SetBytes(end)
+	if current.Cmp(last) > 0 {
+		return nil, errors.New("IP range start is greater than end")
+	}
+
+	networks := make([]*net.IPNet, 0)
+	one := big.NewInt(1)
+	for current.Cmp(last) <= 0 {
+		hostBits := bits
+		if current.Sign() != 0 {
+			hostBits = int(current.TrailingZeroBits())
+		}
+		blockEnd := new(big.Int)
+		for {
+			blockEnd.Lsh(one, uint(hostBits))
+			blockEnd.Add(blockEnd, current).Sub(blockEnd, one)
+			if blockEnd.Cmp(last) <= 0 {
+				break
+			}
+			hostBits--
+		}
+		networks = append(networks, &net.IPNet{
+			IP:   current.FillBytes(make([]byte, bits/8)),
+			Mask: net.CIDRMask(bits-hostBits, bits),
+		})
+		current = blockEnd.Add(blockEnd, one)
+	}
+
+	return networks, nil
+}
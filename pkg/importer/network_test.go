@@ -0,0 +1,87 @@
+package importer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNetworks(t *testing.T) {
+	type args struct {
+		address string
+		end     string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantErr  bool
+		expected []string
+	}{
+		{
+			name:     "single ipv4",
+			args:     args{address: "192.184.51.218"},
+			expected: []string{"192.184.51.218"},
+		},
+		{
+			name:     "single ipv6",
+			args:     args{address: "2001:db8::1"},
+			expected: []string{"2001:db8::1"},
+		},
+		{
+			name:     "cidr with host bits is normalized",
+			args:     args{address: "10.1.2.3/8"},
+			expected: []string{"10.0.0.0/8"},
+		},
+		{
+			name:     "aligned range",
+			args:     args{address: "10.0.0.0", end: "10.0.0.255"},
+			expected: []string{"10.0.0.0/24"},
+		},
+		{
+			name:     "unaligned range",
+			args:     args{address: "10.0.0.1", end: "10.0.0.6"},
+			expected: []string{"10.0.0.1", "10.0.0.2/31", "10.0.0.4/31", "10.0.0.6"},
+		},
+		{
+			name:     "whole ipv4 space",
+			args:     args{address: "0.0.0.0", end: "255.255.255.255"},
+			expected: []string{"0.0.0.0/0"},
+		},
+		{
+			name:     "ipv6 range",
+			args:     args{address: "2001:db8::", end: "2001:db8::ffff"},
+			expected: []string{"2001:db8::/112"},
+		},
+		{
+			name:    "reversed range",
+			args:    args{address: "10.0.0.6", end: "10.0.0.1"},
+			wantErr: true,
+		},
+		{
+			name:    "mixed families",
+			args:    args{address: "10.0.0.1", end: "2001:db8::1"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid ip",
+			args:    args{address: "192.184.51.2181"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid cidr",
+			args:    args{address: "10.0.0.0/33"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networks, err := ParseNetworks(tt.args.address, tt.args.end)
+			require.Equal(t, tt.wantErr, err != nil)
+			var formatted []string
+			for _, network := range networks {
+				formatted = append(formatted, FormatNetwork(network))
+			}
+			require.Equal(t, tt.expected, formatted)
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package importer
+
+import (
+	"net"
+	"sort"
+	"strings"
+)
+
+const (
+	// reportTopValues is count of the most frequent values reported for text column
+	reportTopValues = 10
+	// maxReportedDistinctValues limits memory used by column distribution, values seen after limit is reached are
+	// counted as rows but not as distinct values
+	maxReportedDistinctValues = 100000
+)
+
+// Report describes rows of import without storing them, see Reporter
+type Report struct {
+	// Accepted is count of rows which would be stored, rows dropped by duplicate policy are discarded ones
+	Accepted          int                         `json:"accepted"`
+	Discarded         int                         `json:"discarded"`
+	Networks          int                         `json:"networks"`
+	DiscardedByReason map[DiscardReason]int       `json:"discarded_by_reason"`
+	Columns           ReportColumns               `json:"columns"`
+	Samples           map[DiscardReason][]Discard `json:"samples"`
+}
+
+// ReportColumns describes values of accepted rows by column
+type ReportColumns struct {
+	IPAddress    AddressStats `json:"ip_address"`
+	CountryCode  ValueStats   `json:"country_code"`
+	Country      ValueStats   `json:"country"`
+	City         ValueStats   `json:"city"`
+	Latitude     RangeStats   `json:"latitude"`
+	Longitude    RangeStats   `json:"longitude"`
+	MysteryValue ValueStats   `json:"mystery_value"`
+}
+
+// AddressStats counts rows by IP version and notation
+type AddressStats struct {
+	IPv4 int `json:"ipv4"`
+	IPv6 int `json:"ipv6"`
+	// Single is count of rows with single IP address, Networks with CIDR network and Ranges with ip_address_end
+	Single   int `json:"single"`
+	Networks int `json:"networks"`
+	Ranges   int `json:"ranges"`
+}
+
+// ValueStats is distribution of text column values
+type ValueStats struct {
+	Empty    int `json:"empty"`
+	Distinct int `json:"distinct"`
+	// DistinctTruncated is set if column has more distinct values than are tracked, Distinct and Top count values
+	// seen first only
+	DistinctTruncated bool         `json:"distinct_truncated,omitempty"`
+	Top               []ValueCount `json:"top"`
+}
+
+type ValueCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// RangeStats is distribution of numeric column values
+type RangeStats struct {
+	Min  float64 `json:"min"`
+	Max  float64 `json:"max"`
+	Mean float64 `json:"mean"`
+	Zero int     `json:"zero"`
+}
+
+// Reporter collects report of rows passed to stream, see CSVRowsStream.SetReporter. It is used by the stream
+// producer only, so it isn't safe for concurrent use
+type Reporter struct {
+	samplesPerReason int
+	report           Report
+	countryCodes     valueCounter
+	countries        valueCounter
+	cities           valueCounter
+	mysteryValues    valueCounter
+	latitudes        rangeCounter
+	longitudes       rangeCounter
+}
+
+// NewReporter creates reporter which keeps up to samplesPerReason discarded rows of every reason
+func NewReporter(samplesPerReason int) *Reporter {
+	return &Reporter{
+		samplesPerReason: samplesPerReason,
+		report: Report{
+			DiscardedByReason: make(map[DiscardReason]int),
+			Samples:           make(map[DiscardReason][]Discard),
+		},
+	}
+}
+
+// Report returns report of rows collected so far
+func (reporter *Reporter) Report() Report {
+	report := reporter.report
+	report.Columns.CountryCode = reporter.countryCodes.stats()
+	report.Columns.Country = reporter.countries.stats()
+	report.Columns.City = reporter.cities.stats()
+	report.Columns.MysteryValue = reporter.mysteryValues.stats()
+	report.Columns.Latitude = reporter.latitudes.stats()
+	report.Columns.Longitude = reporter.longitudes.stats()
+
+	return report
+}
+
+func (reporter *Reporter) accept(row CSVRow) {
+	reporter.report.Accepted++
+	// rows are validated before they are accepted, so networks are always parsable here
+	networks, _ := row.Networks()
+	reporter.report.Networks += len(networks)
+
+	addresses := &reporter.report.Columns.IPAddress
+	switch {
+	case len(row.IPAddressEnd) != 0:
+		addresses.Ranges++
+	case strings.Contains(row.IPAddress, "/"):
+		addresses.Networks++
+	default:
+		addresses.Single++
+	}
+	if len(networks) != 0 && len(networks[0].IP) == net.IPv4len {
+		addresses.IPv4++
+	} else {
+		addresses.IPv6++
+	}
+
+	reporter.countryCodes.add(row.CountryCode)
+	reporter.countries.add(row.Country)
+	reporter.cities.add(row.City)
+	reporter.mysteryValues.add(row.MysteryValue)
+	reporter.latitudes.add(row.Latitude)
+	reporter.longitudes.add(row.Longitude)
+}
+
+func (reporter *Reporter) discard(discard Discard) {
+	reporter.report.Discarded++
+	reporter.report.DiscardedByReason[discard.Reason]++
+	if len(reporter.report.Samples[discard.Reason]) < reporter.samplesPerReason {
+		reporter.report.Samples[discard.Reason] = append(reporter.report.Samples[discard.Reason], discard)
+	}
+}
+
+// valueCounter counts rows by text value
+type valueCounter struct {
+	empty     int
+	counts    map[string]int
+	truncated bool
+}
+
+func (counter *valueCounter) add(value string) {
+	if len(value) == 0 {
+		counter.empty++
+		return
+	}
+	if counter.counts == nil {
+		counter.counts = make(map[string]int)
+	}
+	if _, exists := counter.counts[value]; !exists && len(counter.counts) >= maxReportedDistinctValues {
+		counter.truncated = true
+		return
+	}
+	counter.counts[value]++
+}
+
+// stats returns the most frequent values first, values with the same count are ordered alphabetically
+func (counter *valueCounter) stats() ValueStats {
+	values := make([]ValueCount, 0, len(counter.counts))
+	for value, count := range counter.counts {
+		values = append(values, ValueCount{Value: value, Count: count})
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if values[i].Count != values[j].Count {
+			return values[i].Count > values[j].Count
+		}
+		return values[i].Value < values[j].Value
+	})
+	if len(values) > reportTopValues {
+		values = values[:reportTopValues]
+	}
+
+	return ValueStats{
+		Empty:             counter.empty,
+		Distinct:          len(counter.counts),
+		DistinctTruncated: counter.truncated,
+		Top:               values,
+	}
+}
+
+// rangeCounter tracks range of numeric values
+type rangeCounter struct {
+	count   int
+	sum     float64
+	summary RangeStats
+}
+
+func (counter *rangeCounter) add(value float64) {
+	if counter.count == 0 || value < counter.summary.Min {
+		counter.summary.Min = value
+	}
+	if counter.count == 0 || value > counter.summary.Max {
+		counter.summary.Max = value
+	}
+	if value == 0 {
+		counter.summary.Zero++
+	}
+	counter.count++
+	counter.sum += value
+}
+
+func (counter *rangeCounter) stats() RangeStats {
+	summary := counter.summary
+	if counter.count != 0 {
+		summary.Mean = counter.sum / float64(counter.count)
+	}
+
+	return summary
+}
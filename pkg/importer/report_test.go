@@ -0,0 +1,79 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReporter(t *testing.T) {
+	fileContent := "ip_address,ip_address_end,country_code,country,city,latitude,longitude,mystery_value\n" +
+		"192.184.51.218,,RU,Morocco,Willburgh,76.5,-8.5,1\n" +
+		"10.0.0.0/8,,RU,Morocco,Ten,-10,0,1\n" +
+		"10.1.0.0,10.1.0.5,NP,Nepal,Range,0,20,\n" +
+		"2001:db8::1,,NP,Nepal,Six,10,10,2\n" +
+		"192.184.51.218,,RU,Morocco,Conflict,76.5,-8.5,1\n" +
+		"invalid,,RU,Morocco,Willburgh,76.5,-8.5,1\n" +
+		"1.1.1.1,,RU,Morocco,,76.5,-8.5,1\n"
+
+	stream := NewCSVRowsStream(context.Background(), 2, 0)
+	reporter := NewReporter(1)
+	stream.SetReporter(reporter)
+	stream.SetSource("dump.csv")
+	importErr := make(chan error, 1)
+	go func() {
+		defer stream.Close()
+		importErr <- GetCSVImporter().Import(bytes.NewBufferString(fileContent), stream)
+	}()
+	for range stream.Batches() {
+	}
+	require.Nil(t, <-importErr)
+
+	report := reporter.Report()
+	require.Equal(t, 4, report.Accepted)
+	require.Equal(t, 3, report.Discarded)
+	// range is split into 10.1.0.0/30 and 10.1.0.4/31
+	require.Equal(t, 5, report.Networks)
+	require.Equal(t, map[DiscardReason]int{
+		DiscardReasonConflictingIP: 1,
+		DiscardReasonInvalidIP:     1,
+		DiscardReasonMissingCity:   1,
+	}, report.DiscardedByReason)
+	require.Equal(t, []Discard{{
+		Line:   7,
+		Reason: DiscardReasonInvalidIP,
+		Raw:    "invalid,,RU,Morocco,Willburgh,76.5,-8.5,1",
+		Source: "dump.csv",
+	}}, report.Samples[DiscardReasonInvalidIP])
+
+	require.Equal(t, AddressStats{IPv4: 3, IPv6: 1, Single: 2, Networks: 1, Ranges: 1}, report.Columns.IPAddress)
+	require.Equal(t, ValueStats{
+		Distinct: 2,
+		Top:      []ValueCount{{Value: "NP", Count: 2}, {Value: "RU", Count: 2}},
+	}, report.Columns.CountryCode)
+	require.Equal(t, ValueStats{
+		Empty:    1,
+		Distinct: 2,
+		Top:      []ValueCount{{Value: "1", Count: 2}, {Value: "2", Count: 1}},
+	}, report.Columns.MysteryValue)
+	require.Equal(t, RangeStats{Min: -10, Max: 76.5, Mean: 19.125, Zero: 1}, report.Columns.Latitude)
+	require.Equal(t, RangeStats{Min: -8.5, Max: 20, Mean: 5.375, Zero: 1}, report.Columns.Longitude)
+}
+
+func TestValueCounter_Truncated(t *testing.T) {
+	counter := valueCounter{}
+	for i := 0; i < maxReportedDistinctValues; i++ {
+		counter.add(strconv.Itoa(i))
+	}
+	counter.add("again")
+	counter.add("0")
+
+	stats := counter.stats()
+	require.True(t, stats.DistinctTruncated)
+	require.Equal(t, maxReportedDistinctValues, stats.Distinct)
+	require.Equal(t, ValueCount{Value: "0", Count: 2}, stats.Top[0])
+	require.Len(t, stats.Top, reportTopValues)
+}
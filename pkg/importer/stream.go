@@ -0,0 +1,157 @@
+package importer
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/friendsofgo/errors"
+)
+
+// CSVRowsStream passes imported rows to consumer in batches through bounded channel instead of collecting them,
+// so memory usage doesn't depend on source size. Counts of accepted and discarded rows could be read while import is
+// running
+type CSVRowsStream struct {
+	ctx                context.Context
+	batches            chan []CSVRow
+	batch              []CSVRow
+	batchSize          int
+	rowsAcceptedCount  atomic.Int64
+	rowsDiscardedCount atomic.Int64
+	discardedByReason  map[DiscardReason]int
+	resolver           *duplicateResolver
+	validator          *Validator
+	rejectedWriter     *RejectedWriter
+	reporter           *Reporter
+	// source is name of source being imported, it is written with discarded rows
+	source string
+}
+
+// NewCSVRowsStream creates stream which holds at most bufferSize full batches of batchSize rows waiting for consumer
+func NewCSVRowsStream(ctx context.Context, batchSize, bufferSize int) *CSVRowsStream {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	return &CSVRowsStream{
+		ctx:       ctx,
+		batches:   make(chan []CSVRow, bufferSize),
+		batch:     make([]CSVRow, 0, batchSize),
+		batchSize: batchSize,
+		resolver:  newDuplicateResolver(DuplicatePolicyFirstWins),
+	}
+}
+
+// Batches returns channel with row batches, it is closed by Close
+func (stream *CSVRowsStream) Batches() <-chan []CSVRow {
+	return stream.batches
+}
+
+// Close sends the last incomplete batch and closes batches channel. Should be called by producer once import is
+// done
+func (stream *CSVRowsStream) Close() {
+	if len(stream.batch) != 0 {
+		_ = stream.flush()
+	}
+	close(stream.batches)
+}
+
+func (stream *CSVRowsStream) addRow(row interface{}) error {
+	csvRow, isCorrectType := row.(CSVRow)
+	if !isCorrectType {
+		return errors.New("incorrect csv row type")
+	}
+	stream.batch = append(stream.batch, csvRow)
+	stream.rowsAcceptedCount.Add(1)
+	if stream.reporter != nil {
+		stream.reporter.accept(csvRow)
+	}
+	if len(stream.batch) < stream.batchSize {
+		return nil
+	}
+
+	return stream.flush()
+}
+
+// SetDuplicatePolicy sets policy applied to rows with the same network, first-wins is used by default. last-wins and
+// reject-all-conflicting policies hold all rows in memory until Finish
+func (stream *CSVRowsStream) SetDuplicatePolicy(policy DuplicatePolicy) {
+	stream.resolver = newDuplicateResolver(policy)
+}
+
+func (stream *CSVRowsStream) duplicates() *duplicateResolver {
+	return stream.resolver
+}
+
+// SetValidator sets validator of rows, only mandatory restrictions are checked by default
+func (stream *CSVRowsStream) SetValidator(validator *Validator) {
+	stream.validator = validator
+}
+
+func (stream *CSVRowsStream) validation() *Validator {
+	return stream.validator
+}
+
+// Finish passes rows held by duplicate policy to consumer, should be called by producer once all sources are
+// imported
+func (stream *CSVRowsStream) Finish() error {
+	return stream.resolver.finish(stream)
+}
+
+func (stream *CSVRowsStream) flush() error {
+	select {
+	case stream.batches <- stream.batch:
+		stream.batch = make([]CSVRow, 0, stream.batchSize)
+		return nil
+	case <-stream.ctx.Done():
+		return errors.Wrap(stream.ctx.Err(), "stream is closed")
+	}
+}
+
+// GetAcceptedCnt returns count of rows passed to the stream
+func (stream *CSVRowsStream) GetAcceptedCnt() int {
+	return int(stream.rowsAcceptedCount.Load())
+}
+
+func (stream *CSVRowsStream) GetDiscardedCnt() int {
+	return int(stream.rowsDiscardedCount.Load())
+}
+
+// GetDiscardedCntByReason returns counts of discarded rows by reason, it should be called once import is done
+func (stream *CSVRowsStream) GetDiscardedCntByReason() map[DiscardReason]int {
+	return stream.discardedByReason
+}
+
+// SetSource sets name of source imported next, several sources could be imported into the same stream one by one
+func (stream *CSVRowsStream) SetSource(source string) {
+	stream.source = source
+	stream.resolver.source = source
+}
+
+// SetRejectedWriter makes stream write every discarded row to rejectedWriter
+func (stream *CSVRowsStream) SetRejectedWriter(rejectedWriter *RejectedWriter) {
+	stream.rejectedWriter = rejectedWriter
+}
+
+// SetReporter makes stream pass every accepted and discarded row to reporter
+func (stream *CSVRowsStream) SetReporter(reporter *Reporter) {
+	stream.reporter = reporter
+}
+
+func (stream *CSVRowsStream) AddDiscarded(discard Discard) error {
+	if stream.discardedByReason == nil {
+		stream.discardedByReason = make(map[DiscardReason]int)
+	}
+	stream.discardedByReason[discard.Reason]++
+	stream.rowsDiscardedCount.Add(1)
+	if len(discard.Source) == 0 {
+		discard.Source = stream.source
+	}
+	if stream.reporter != nil {
+		stream.reporter.discard(discard)
+	}
+	if stream.rejectedWriter == nil {
+		return nil
+	}
+
+	return stream.rejectedWriter.Write(discard)
+}
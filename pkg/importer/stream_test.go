@@ -0,0 +1,118 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVRowsStream(t *testing.T) {
+	fileContent := csvHeader + "\n" +
+		"192.184.51.218,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n" +
+		"160.168.85.54,BO,Cuba,Mohamedview,-66.20896958745531,81.62948730878543,8879434387\n" +
+		"160.168.85.54,BO,Cuba,Mohamedview,-66.20896958745531,81.62948730878543,8879434387\n" +
+		"214.165.161.44,PR,Republic of Korea,West Erika,48.92021642445653,14.900399560492929,3829378711\n" +
+		"51.23.171.108,SK,Slovakia (Slovak Republic),Mrazview,,,0\n" +
+		"187.197.68.39,MM,Zimbabwe,North Anamouth,48.82685320435576,2.9300655090904684,8762174736"
+
+	stream := NewCSVRowsStream(context.Background(), 3, 0)
+	importErr := make(chan error, 1)
+	go func() {
+		defer stream.Close()
+		importErr <- GetCSVImporter().Import(bytes.NewBufferString(fileContent), stream)
+	}()
+
+	var batchSizes []int
+	var ips []string
+	for batch := range stream.Batches() {
+		batchSizes = append(batchSizes, len(batch))
+		for _, row := range batch {
+			ips = append(ips, row.IPAddress)
+		}
+	}
+
+	require.Nil(t, <-importErr)
+	require.Equal(t, []int{3, 1}, batchSizes)
+	require.Equal(t, []string{"192.184.51.218", "160.168.85.54", "214.165.161.44", "187.197.68.39"}, ips)
+	require.Equal(t, 4, stream.GetAcceptedCnt())
+	require.Equal(t, 2, stream.GetDiscardedCnt())
+}
+
+func TestCSVRowsStream_Cancel(t *testing.T) {
+	fileContent := csvHeader + "\n" +
+		"192.184.51.218,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n" +
+		"160.168.85.54,BO,Cuba,Mohamedview,-66.20896958745531,81.62948730878543,8879434387\n"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stream := NewCSVRowsStream(ctx, 1, 0)
+	err := GetCSVImporter().Import(bytes.NewBufferString(fileContent), stream)
+	stream.Close()
+
+	require.ErrorIs(t, err, context.Canceled)
+	_, open := <-stream.Batches()
+	require.False(t, open)
+}
+
+func TestCSVRowsStream_RejectedWriter(t *testing.T) {
+	fileContent := csvHeader + "\n" +
+		"192.184.51.218,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n" +
+		"51.23.171.108,SK,\"Slovakia, Slovak Republic\",Mrazview,,,0\n" +
+		"192.184.51.218,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n" +
+		"160.168.85.54,BO,Cuba,Mohamedview,-66.20896958745531,81.62948730878543\n" +
+		"160.168.85.54,BO,Cuba,Mohamedview,-96.20896958745531,81.62948730878543,8879434387\n"
+
+	rejected := &bytes.Buffer{}
+	rejectedWriter := NewRejectedWriter(rejected)
+	stream := NewCSVRowsStream(context.Background(), 10, 1)
+	stream.SetRejectedWriter(rejectedWriter)
+	stream.SetSource("dump.csv")
+	err := GetCSVImporter().Import(bytes.NewBufferString(fileContent), stream)
+	stream.Close()
+
+	require.Nil(t, err)
+	require.Nil(t, rejectedWriter.Flush())
+	require.Equal(t, "line,reason,row,source\n"+
+		"3,decode_error,\"51.23.171.108,SK,\"\"Slovakia, Slovak Republic\"\",Mrazview,,,0\",dump.csv\n"+
+		"4,duplicate_ip,\"192.184.51.218,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\","+
+		"dump.csv\n"+
+		"5,decode_error,\"160.168.85.54,BO,Cuba,Mohamedview,-66.20896958745531,81.62948730878543\",dump.csv\n"+
+		"6,coordinates_out_of_range,"+
+		"\"160.168.85.54,BO,Cuba,Mohamedview,-96.20896958745531,81.62948730878543,8879434387\",dump.csv\n",
+		rejected.String(),
+	)
+	require.Equal(t, map[DiscardReason]int{
+		DiscardReasonDecodeError:           2,
+		DiscardReasonDuplicateIP:           1,
+		DiscardReasonCoordinatesOutOfRange: 1,
+	}, stream.GetDiscardedCntByReason())
+}
+
+func TestCSVRowsStream_MultipleSources(t *testing.T) {
+	stream := NewCSVRowsStream(context.Background(), 10, 2)
+	rejected := &bytes.Buffer{}
+	stream.SetRejectedWriter(NewRejectedWriter(rejected))
+	sources := map[string]string{
+		"first.csv": csvHeader + "\n" +
+			"192.184.51.218,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n",
+		"second.csv": csvHeader + "\n" +
+			"160.168.85.54,BO,Cuba,Mohamedview,-66.20896958745531,81.62948730878543,8879434387\n" +
+			"192.184.51.218,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n",
+	}
+	for _, name := range []string{"first.csv", "second.csv"} {
+		stream.SetSource(name)
+		require.Nil(t, GetCSVImporter().Import(bytes.NewBufferString(sources[name]), stream))
+	}
+	stream.Close()
+
+	require.Equal(t, 2, stream.GetAcceptedCnt())
+	require.Equal(t, map[DiscardReason]int{DiscardReasonDuplicateIP: 1}, stream.GetDiscardedCntByReason())
+	require.Nil(t, stream.rejectedWriter.Flush())
+	require.Equal(t, "line,reason,row,source\n"+
+		"3,duplicate_ip,\"192.184.51.218,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\","+
+		"second.csv\n",
+		rejected.String(),
+	)
+}
@@ -0,0 +1,128 @@
+package importer
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/friendsofgo/errors"
+)
+
+// names of built-in optional validation rules
+const (
+	// RuleCountryCode requires country code to be ISO 3166-1 alpha-2 code
+	RuleCountryCode = "country_code"
+	// RuleCountryName requires country name to match country code, see Country.MatchesName
+	RuleCountryName = "country_name"
+	// RuleMysteryValue requires mystery value to be non-negative integer
+	RuleMysteryValue = "mystery_value"
+	// RuleCleanText rejects text fields with leading or trailing whitespace and control characters
+	RuleCleanText = "clean_text"
+)
+
+// ValidationRule checks single restriction of row and returns discard reason if row violates it
+type ValidationRule func(row *CSVRow) DiscardReason
+
+var validationRules = map[string]ValidationRule{
+	RuleCountryCode:  validateCountryCode,
+	RuleCountryName:  validateCountryName,
+	RuleMysteryValue: validateMysteryValue,
+	RuleCleanText:    validateCleanText,
+}
+
+// ValidationRuleNames returns names of built-in optional rules
+func ValidationRuleNames() []string {
+	names := make([]string, 0, len(validationRules))
+	for name := range validationRules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Validator is chain of optional rules checked after mandatory restrictions (see CSVRow.Validate), row is
+// discarded by the first violated one. Nil validator checks mandatory restrictions only
+type Validator struct {
+	rules []ValidationRule
+}
+
+func NewValidator(rules ...ValidationRule) *Validator {
+	return &Validator{rules: rules}
+}
+
+// NewValidatorByNames returns validator of built-in rules in the given order
+func NewValidatorByNames(names []string) (*Validator, error) {
+	rules := make([]ValidationRule, 0, len(names))
+	for _, name := range names {
+		rule, exists := validationRules[name]
+		if !exists {
+			return nil, errors.Errorf(
+				"unknown validation rule %q, known rules: %s",
+				name,
+				strings.Join(ValidationRuleNames(), ", "),
+			)
+		}
+		rules = append(rules, rule)
+	}
+
+	return NewValidator(rules...), nil
+}
+
+// Validate returns reason of the first violated restriction or empty reason if row is valid
+func (validator *Validator) Validate(row *CSVRow) DiscardReason {
+	reason := row.Validate()
+	if len(reason) != 0 || validator == nil {
+		return reason
+	}
+	for _, rule := range validator.rules {
+		if reason = rule(row); len(reason) != 0 {
+			return reason
+		}
+	}
+
+	return ""
+}
+
+func validateCountryCode(row *CSVRow) DiscardReason {
+	if _, found := LookupCountry(row.CountryCode); !found {
+		return DiscardReasonInvalidCountryCode
+	}
+
+	return ""
+}
+
+func validateCountryName(row *CSVRow) DiscardReason {
+	country, found := LookupCountry(row.CountryCode)
+	if !found {
+		return DiscardReasonInvalidCountryCode
+	}
+	if !country.MatchesName(row.Country) {
+		return DiscardReasonCountryMismatch
+	}
+
+	return ""
+}
+
+func validateMysteryValue(row *CSVRow) DiscardReason {
+	if len(row.MysteryValue) == 0 {
+		return DiscardReasonInvalidMysteryValue
+	}
+	for _, r := range row.MysteryValue {
+		if r < '0' || r > '9' {
+			return DiscardReasonInvalidMysteryValue
+		}
+	}
+
+	return ""
+}
+
+func validateCleanText(row *CSVRow) DiscardReason {
+	for _, field := range []string{row.CountryCode, row.Country, row.City, row.MysteryValue} {
+		if strings.TrimSpace(field) != field || strings.IndexFunc(field, unicode.IsControl) != -1 {
+			return DiscardReasonInvalidCharacters
+		}
+	}
+
+	return ""
+}
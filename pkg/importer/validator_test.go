@@ -0,0 +1,126 @@
+package importer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	valid := CSVRow{
+		IPAddress:    "192.184.51.218",
+		CountryCode:  "MA",
+		Country:      "Morocco",
+		City:         "Willburgh",
+		Latitude:     76.7892707471672,
+		Longitude:    -8.617777079132821,
+		MysteryValue: "2815330924",
+	}
+	tests := []struct {
+		name     string
+		rules    []string
+		modify   func(row *CSVRow)
+		expected DiscardReason
+	}{
+		{
+			name:   "no rules",
+			modify: func(row *CSVRow) { row.CountryCode = "XX" },
+		},
+		{
+			name:     "mandatory restrictions go first",
+			rules:    []string{RuleCountryCode},
+			modify:   func(row *CSVRow) { row.CountryCode, row.City = "XX", "" },
+			expected: DiscardReasonMissingCity,
+		},
+		{
+			name:   "all rules pass",
+			rules:  ValidationRuleNames(),
+			modify: func(row *CSVRow) {},
+		},
+		{
+			name:     "unknown country code",
+			rules:    []string{RuleCountryCode},
+			modify:   func(row *CSVRow) { row.CountryCode = "XX" },
+			expected: DiscardReasonInvalidCountryCode,
+		},
+		{
+			name:     "lowercase country code",
+			rules:    []string{RuleCountryCode},
+			modify:   func(row *CSVRow) { row.CountryCode = "ma" },
+			expected: DiscardReasonInvalidCountryCode,
+		},
+		{
+			name:     "country mismatch",
+			rules:    []string{RuleCountryName},
+			modify:   func(row *CSVRow) { row.CountryCode = "RU" },
+			expected: DiscardReasonCountryMismatch,
+		},
+		{
+			name:     "country mismatch with unknown code",
+			rules:    []string{RuleCountryName},
+			modify:   func(row *CSVRow) { row.CountryCode = "XX" },
+			expected: DiscardReasonInvalidCountryCode,
+		},
+		{
+			name:     "not numeric mystery value",
+			rules:    []string{RuleMysteryValue},
+			modify:   func(row *CSVRow) { row.MysteryValue = "-1" },
+			expected: DiscardReasonInvalidMysteryValue,
+		},
+		{
+			name:     "empty mystery value",
+			rules:    []string{RuleMysteryValue},
+			modify:   func(row *CSVRow) { row.MysteryValue = "" },
+			expected: DiscardReasonInvalidMysteryValue,
+		},
+		{
+			name:     "surrounding whitespace",
+			rules:    []string{RuleCleanText},
+			modify:   func(row *CSVRow) { row.City = "Willburgh " },
+			expected: DiscardReasonInvalidCharacters,
+		},
+		{
+			name:     "control character",
+			rules:    []string{RuleCleanText},
+			modify:   func(row *CSVRow) { row.Country = "Mor\x00occo" },
+			expected: DiscardReasonInvalidCharacters,
+		},
+		{
+			name:     "the first violated rule wins",
+			rules:    []string{RuleMysteryValue, RuleCountryName},
+			modify:   func(row *CSVRow) { row.CountryCode, row.MysteryValue = "RU", "x" },
+			expected: DiscardReasonInvalidMysteryValue,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator, err := NewValidatorByNames(tt.rules)
+			require.Nil(t, err)
+			row := valid
+			tt.modify(&row)
+
+			require.Equal(t, tt.expected, validator.Validate(&row))
+		})
+	}
+}
+
+func TestNewValidatorByNames_UnknownRule(t *testing.T) {
+	_, err := NewValidatorByNames([]string{RuleCountryCode, "unknown"})
+	require.NotNil(t, err)
+}
+
+func TestValidator_Import(t *testing.T) {
+	fileContent := csvHeader + "\n" +
+		"192.184.51.218,RU,Morocco,Willburgh,76.7892707471672,-8.617777079132821,2815330924\n" +
+		"160.168.85.54,CU,Cuba,Mohamedview,-66.20896958745531,81.62948730878543,8879434387\n"
+	validator, err := NewValidatorByNames([]string{RuleCountryName})
+	require.Nil(t, err)
+	rows := &CSVRows{}
+	rows.SetValidator(validator)
+
+	require.Nil(t, GetCSVImporter().Import(bytes.NewBufferString(fileContent), rows))
+	require.Len(t, rows.GetRows(), 1)
+	require.Equal(t, "160.168.85.54", rows.GetRows()[0].IPAddress)
+	require.Equal(t, map[DiscardReason]int{DiscardReasonCountryMismatch: 1}, rows.GetDiscardedCntByReason())
+}
@@ -0,0 +1,220 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: geolocation/v1/geolocation.proto
+
+package geolocationv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// GeolocationServiceClient is the client API for GeolocationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GeolocationServiceClient interface {
+	// Locate returns location of IP address, NOT_FOUND status is returned if there is no such IP address and
+	// INVALID_ARGUMENT if it isn't valid
+	Locate(ctx context.Context, in *LocateRequest, opts ...grpc.CallOption) (*LocateResponse, error)
+	// LocateMany returns result for every requested IP address in the same order, count of IP addresses is limited
+	// by batchLocateLimit config
+	LocateMany(ctx context.Context, in *LocateManyRequest, opts ...grpc.CallOption) (*LocateManyResponse, error)
+	// LocateStream returns result for every received IP address as soon as it is located
+	LocateStream(ctx context.Context, opts ...grpc.CallOption) (GeolocationService_LocateStreamClient, error)
+}
+
+type geolocationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGeolocationServiceClient(cc grpc.ClientConnInterface) GeolocationServiceClient {
+	return &geolocationServiceClient{cc}
+}
+
+func (c *geolocationServiceClient) Locate(ctx context.Context, in *LocateRequest, opts ...grpc.CallOption) (*LocateResponse, error) {
+	out := new(LocateResponse)
+	err := c.cc.Invoke(ctx, "/geolocation.v1.GeolocationService/Locate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geolocationServiceClient) LocateMany(ctx context.Context, in *LocateManyRequest, opts ...grpc.CallOption) (*LocateManyResponse, error) {
+	out := new(LocateManyResponse)
+	err := c.cc.Invoke(ctx, "/geolocation.v1.GeolocationService/LocateMany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geolocationServiceClient) LocateStream(ctx context.Context, opts ...grpc.CallOption) (GeolocationService_LocateStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GeolocationService_ServiceDesc.Streams[0], "/geolocation.v1.GeolocationService/LocateStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geolocationServiceLocateStreamClient{stream}
+	return x, nil
+}
+
+type GeolocationService_LocateStreamClient interface {
+	Send(*LocateStreamRequest) error
+	Recv() (*LocateStreamResponse, error)
+	grpc.ClientStream
+}
+
+type geolocationServiceLocateStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geolocationServiceLocateStreamClient) Send(m *LocateStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *geolocationServiceLocateStreamClient) Recv() (*LocateStreamResponse, error) {
+	m := new(LocateStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GeolocationServiceServer is the server API for GeolocationService service.
+// All implementations must embed UnimplementedGeolocationServiceServer
+// for forward compatibility
+type GeolocationServiceServer interface {
+	// Locate returns location of IP address, NOT_FOUND status is returned if there is no such IP address and
+	// INVALID_ARGUMENT if it isn't valid
+	Locate(context.Context, *LocateRequest) (*LocateResponse, error)
+	// LocateMany returns result for every requested IP address in the same order, count of IP addresses is limited
+	// by batchLocateLimit config
+	LocateMany(context.Context, *LocateManyRequest) (*LocateManyResponse, error)
+	// LocateStream returns result for every received IP address as soon as it is located
+	LocateStream(GeolocationService_LocateStreamServer) error
+	mustEmbedUnimplementedGeolocationServiceServer()
+}
+
+// UnimplementedGeolocationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGeolocationServiceServer struct {
+}
+
+func (UnimplementedGeolocationServiceServer) Locate(context.Context, *LocateRequest) (*LocateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Locate not implemented")
+}
+func (UnimplementedGeolocationServiceServer) LocateMany(context.Context, *LocateManyRequest) (*LocateManyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocateMany not implemented")
+}
+func (UnimplementedGeolocationServiceServer) LocateStream(GeolocationService_LocateStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method LocateStream not implemented")
+}
+func (UnimplementedGeolocationServiceServer) mustEmbedUnimplementedGeolocationServiceServer() {}
+
+// UnsafeGeolocationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GeolocationServiceServer will
+// result in compilation errors.
+type UnsafeGeolocationServiceServer interface {
+	mustEmbedUnimplementedGeolocationServiceServer()
+}
+
+func RegisterGeolocationServiceServer(s grpc.ServiceRegistrar, srv GeolocationServiceServer) {
+	s.RegisterService(&GeolocationService_ServiceDesc, srv)
+}
+
+func _GeolocationService_Locate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeolocationServiceServer).Locate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/geolocation.v1.GeolocationService/Locate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeolocationServiceServer).Locate(ctx, req.(*LocateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeolocationService_LocateMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocateManyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeolocationServiceServer).LocateMany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/geolocation.v1.GeolocationService/LocateMany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeolocationServiceServer).LocateMany(ctx, req.(*LocateManyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeolocationService_LocateStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GeolocationServiceServer).LocateStream(&geolocationServiceLocateStreamServer{stream})
+}
+
+type GeolocationService_LocateStreamServer interface {
+	Send(*LocateStreamResponse) error
+	Recv() (*LocateStreamRequest, error)
+	grpc.ServerStream
+}
+
+type geolocationServiceLocateStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *geolocationServiceLocateStreamServer) Send(m *LocateStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *geolocationServiceLocateStreamServer) Recv() (*LocateStreamRequest, error) {
+	m := new(LocateStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GeolocationService_ServiceDesc is the grpc.ServiceDesc for GeolocationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GeolocationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "geolocation.v1.GeolocationService",
+	HandlerType: (*GeolocationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Locate",
+			Handler:    _GeolocationService_Locate_Handler,
+		},
+		{
+			MethodName: "LocateMany",
+			Handler:    _GeolocationService_LocateMany_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "LocateStream",
+			Handler:       _GeolocationService_LocateStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "geolocation/v1/geolocation.proto",
+}
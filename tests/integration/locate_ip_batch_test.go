@@ -0,0 +1,98 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type locateIPBatchResult struct {
+	IPAddress string `json:"ip_address"`
+	Status    string `json:"status"`
+	locateIPResponse
+}
+
+type locateIPBatchResponse struct {
+	Error   string                `json:"error,omitempty"`
+	Results []locateIPBatchResult `json:"results,omitempty"`
+}
+
+type locateIPBatchRequest struct {
+	IPAddresses []string `json:"ip_addresses"`
+}
+
+// TestLocateIPBatch test relies on data_dump.csv data provided with challenge
+func TestLocateIPBatch(t *testing.T) {
+	nepal := locateIPResponse{
+		Country: "Nepal",
+		City:    "DuBuquemouth",
+	}
+	nepal.Coordinates.Longitude = -84.87503094689836
+	nepal.Coordinates.Latitude = 7.206435933364332
+
+	type args struct {
+		ips []string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		expected *locateIPBatchResponse
+		status   int
+	}{
+		{
+			name: "mixed results",
+			args: args{
+				ips: []string{"200.106.141.15", "33.173.188.3", "33.173.188", "200.106.141.15"},
+			},
+			expected: &locateIPBatchResponse{
+				Results: []locateIPBatchResult{
+					{IPAddress: "200.106.141.15", Status: "found", locateIPResponse: nepal},
+					{IPAddress: "33.173.188.3", Status: "not_found"},
+					{IPAddress: "33.173.188", Status: "invalid"},
+					{IPAddress: "200.106.141.15", Status: "found", locateIPResponse: nepal},
+				},
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "empty batch",
+			args: args{
+				ips: []string{},
+			},
+			expected: &locateIPBatchResponse{
+				Error: "no IP addresses",
+			},
+			status: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, statusCode := locateIPBatch(t, tt.args.ips)
+			require.Equal(t, tt.status, statusCode)
+			require.Equal(t, tt.expected, &response)
+		})
+	}
+}
+
+func locateIPBatch(t *testing.T, ips []string) (locateIPBatchResponse, int) {
+	request := locateIPBatchRequest{IPAddresses: ips}
+	jsonData, err := json.Marshal(request)
+	require.Nil(t, err)
+	req, err := http.NewRequest("POST", "http://localhost:3011/api/ip/locate/batch", bytes.NewBuffer(jsonData))
+	require.Nil(t, err)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	require.Nil(t, err)
+	defer func(Body io.ReadCloser) { Body.Close() }(resp.Body)
+
+	response := locateIPBatchResponse{}
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	require.Nil(t, err)
+
+	return response, resp.StatusCode
+}
@@ -103,6 +103,11 @@ func TestLocateIP(t *testing.T) {
 			require.Equal(t, tt.wantErr, err != nil)
 			require.Equal(t, tt.status, statusCode)
 			require.Equal(t, tt.expected, &response)
+
+			response, statusCode, err = locateIPByPath(t, tt.args.ip)
+			require.Equal(t, tt.wantErr, err != nil)
+			require.Equal(t, tt.status, statusCode)
+			require.Equal(t, tt.expected, &response)
 		})
 	}
 }
@@ -125,3 +130,15 @@ func locateIP(t *testing.T, ip string) (locateIPResponse, int, error) {
 
 	return response, resp.StatusCode, nil
 }
+
+func locateIPByPath(t *testing.T, ip string) (locateIPResponse, int, error) {
+	resp, err := http.Get("http://localhost:3011/api/ip/" + ip)
+	require.Nil(t, err)
+	defer func(Body io.ReadCloser) { Body.Close() }(resp.Body)
+
+	response := locateIPResponse{}
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	require.Nil(t, err)
+
+	return response, resp.StatusCode, nil
+}
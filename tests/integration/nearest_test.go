@@ -0,0 +1,49 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type nearestResult struct {
+	IPAddress  string  `json:"ip_address"`
+	DistanceKm float64 `json:"distance_km"`
+	locateIPResponse
+}
+
+type nearestResponse struct {
+	Error   string          `json:"error,omitempty"`
+	Results []nearestResult `json:"results,omitempty"`
+}
+
+// TestNearest test relies on data_dump.csv data provided with challenge
+func TestNearest(t *testing.T) {
+	response, statusCode := nearest(t, "lat=-84.87503094689836&lon=7.206435933364332&limit=3")
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Len(t, response.Results, 3)
+	require.Equal(t, "200.106.141.15", response.Results[0].IPAddress)
+	require.Equal(t, "Nepal", response.Results[0].Country)
+	require.Zero(t, response.Results[0].DistanceKm)
+	require.LessOrEqual(t, response.Results[1].DistanceKm, response.Results[2].DistanceKm)
+
+	response, statusCode = nearest(t, "lat=91&lon=0")
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Equal(t, "invalid lat, must be between -90 and 90", response.Error)
+}
+
+func nearest(t *testing.T, query string) (nearestResponse, int) {
+	resp, err := http.Get(fmt.Sprintf("http://localhost:3011/api/geo/nearest?%s", query))
+	require.Nil(t, err)
+	defer func(Body io.ReadCloser) { Body.Close() }(resp.Body)
+
+	response := nearestResponse{}
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	require.Nil(t, err)
+
+	return response, resp.StatusCode
+}
@@ -0,0 +1,58 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type searchResult struct {
+	IPAddress string `json:"ip_address"`
+	CreatedAt string `json:"created_at,omitempty"`
+	locateIPResponse
+}
+
+type searchResponse struct {
+	Error      string         `json:"error,omitempty"`
+	Results    []searchResult `json:"results,omitempty"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+// TestSearch test relies on data_dump.csv data provided with challenge
+func TestSearch(t *testing.T) {
+	response, statusCode := search(t, "city=dubuquemouth&ip_prefix=200.106.0.0/16")
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Len(t, response.Results, 1)
+	require.Equal(t, "200.106.141.15", response.Results[0].IPAddress)
+	require.Equal(t, "Nepal", response.Results[0].Country)
+	require.Empty(t, response.NextCursor)
+
+	response, statusCode = search(t, "country=Nepal&limit=2")
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Len(t, response.Results, 2)
+	require.NotEmpty(t, response.NextCursor)
+	next, statusCode := search(t, "country=Nepal&limit=2&cursor="+response.NextCursor)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.NotEmpty(t, next.Results)
+	require.NotEqual(t, response.Results[1].IPAddress, next.Results[0].IPAddress)
+
+	response, statusCode = search(t, "country=Nepal&sort=city&cursor="+response.NextCursor)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Equal(t, "invalid cursor", response.Error)
+}
+
+func search(t *testing.T, query string) (searchResponse, int) {
+	resp, err := http.Get(fmt.Sprintf("http://localhost:3011/api/geolocations?%s", query))
+	require.Nil(t, err)
+	defer func(Body io.ReadCloser) { Body.Close() }(resp.Body)
+
+	response := searchResponse{}
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	require.Nil(t, err)
+
+	return response, resp.StatusCode
+}
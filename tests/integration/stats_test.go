@@ -0,0 +1,69 @@
+package integration
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type statsResponse struct {
+	Records   int `json:"records"`
+	Countries int `json:"countries"`
+	Cities    int `json:"cities"`
+}
+
+type countryStats struct {
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	Records int    `json:"records"`
+}
+
+type cityStats struct {
+	CountryCode string `json:"country_code"`
+	City        string `json:"city"`
+	Records     int    `json:"records"`
+}
+
+// TestStats test relies on data_dump.csv data provided with challenge
+func TestStats(t *testing.T) {
+	stats := statsResponse{}
+	require.Equal(t, http.StatusOK, getJSON(t, "/api/stats", &stats))
+	require.Greater(t, stats.Records, 0)
+	require.Greater(t, stats.Countries, 0)
+	require.Greater(t, stats.Cities, 0)
+
+	countries := struct {
+		Results []countryStats `json:"results"`
+	}{}
+	require.Equal(t, http.StatusOK, getJSON(t, "/api/stats/countries", &countries))
+	// records without country are summarized with empty code
+	require.GreaterOrEqual(t, len(countries.Results), stats.Countries)
+	records := 0
+	for _, country := range countries.Results {
+		records += country.Records
+	}
+	require.Equal(t, stats.Records, records)
+
+	cities := struct {
+		Results []cityStats `json:"results"`
+	}{}
+	require.Equal(t, http.StatusOK, getJSON(t, "/api/stats/cities?country_code=NP&limit=3", &cities))
+	require.LessOrEqual(t, len(cities.Results), 3)
+	for _, city := range cities.Results {
+		require.Equal(t, "NP", city.CountryCode)
+	}
+}
+
+func getJSON(t *testing.T, path string, response interface{}) int {
+	resp, err := http.Get("http://localhost:3011" + path)
+	require.Nil(t, err)
+	defer func(Body io.ReadCloser) { Body.Close() }(resp.Body)
+
+	err = json.NewDecoder(resp.Body).Decode(response)
+	require.Nil(t, err)
+
+	return resp.StatusCode
+}